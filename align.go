@@ -0,0 +1,46 @@
+package cnholiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// AlignedBusinessDay 返回 yearsBack 年前同月中与 date 序号相同的工作日，用于按工作日序号而不是日历日期做同比
+// 例如 date 是当月第 5 个工作日，则返回去年同月第 5 个工作日；去年同月工作日不足时返回该月最后一个工作日。
+// 春节等假期在不同年份落在不同日期，按工作日序号对齐可以避免同比时一边是工作日、一边是假期。
+// date 必须是工作日，yearsBack 为负数时向后对齐
+func (c *Checker) AlignedBusinessDay(date time.Time, yearsBack int) (time.Time, error) {
+	day := truncateDay(date)
+	isWorkday, err := c.IsWorkday(day)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !isWorkday {
+		return time.Time{}, fmt.Errorf("%s 不是工作日", day.Format("2006-01-02"))
+	}
+
+	n, err := c.CountWorkdaysBetween(time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location()), day)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var last time.Time
+	target := time.Date(day.Year()-yearsBack, day.Month(), 1, 0, 0, 0, 0, day.Location())
+	for d := target; d.Month() == target.Month(); d = d.AddDate(0, 0, 1) {
+		isWorkday, err := c.IsWorkday(d)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if !isWorkday {
+			continue
+		}
+		if n--; n == 0 {
+			return d, nil
+		}
+		last = d
+	}
+	if last.IsZero() {
+		return time.Time{}, fmt.Errorf("%d 年 %d 月没有工作日", target.Year(), target.Month())
+	}
+	return last, nil
+}
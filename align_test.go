@@ -0,0 +1,43 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlignedBusinessDay(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+
+	tests := []struct {
+		date      string
+		yearsBack int
+		want      string
+	}{
+		{"2026-02-05", 1, "2025-02-08"}, // 2 月第 4 个工作日，2025 年为春节调休上班的周六
+		{"2026-10-08", 1, "2025-10-09"}, // 国庆后第 1 个工作日
+		{"2026-10-08", 2, "2024-10-08"},
+		{"2025-02-28", 1, "2024-02-29"}, // 2025 年 2 月第 19 个工作日，2024 年 2 月只有 18 个
+		{"2024-10-08", -2, "2026-10-08"},
+	}
+	for _, tt := range tests {
+		got, err := checker.AlignedBusinessDay(day(tt.date), tt.yearsBack)
+		if err != nil {
+			t.Errorf("AlignedBusinessDay(%s, %d) failed: %v", tt.date, tt.yearsBack, err)
+			continue
+		}
+		if got.Format("2006-01-02") != tt.want {
+			t.Errorf("AlignedBusinessDay(%s, %d) = %s, want %s", tt.date, tt.yearsBack, got.Format("2006-01-02"), tt.want)
+		}
+	}
+
+	if _, err := checker.AlignedBusinessDay(day("2026-10-01"), 1); err == nil {
+		t.Error("expected error for non-workday")
+	}
+	if _, err := checker.AlignedBusinessDay(day("2026-10-08"), 10); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
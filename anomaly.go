@@ -0,0 +1,80 @@
+package cnholiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// 异常检测使用的历年规律
+const (
+	maxConsecutiveWorkdays = 7   // 调休导致的连续上班天数上限
+	minYearWorkdays        = 240 // 全年工作日天数(含调休工作日)的下限
+	maxYearWorkdays        = 256 // 全年工作日天数的上限
+)
+
+// Anomaly 新加载的年份数据与历年规律不符的告警
+// 数据格式正确但内容可疑，例如上游数据被截断或调休日期写错，这类数据仍会写入缓存，
+// 由 Config.OnAnomaly 回调和 LoadReport.Anomalies 提示使用方在数据流入薪资等系统前核对
+type Anomaly struct {
+	Year   int
+	Source Source
+	Issues []ValidationIssue
+}
+
+// Error 实现 error 接口，便于直接记录日志
+func (a *Anomaly) Error() string {
+	return fmt.Sprintf("%d 年数据(%s)存在 %d 处异常，第一处: %s", a.Year, a.Source, len(a.Issues), a.Issues[0])
+}
+
+// DetectAnomalies 检查已加载的年份数据是否符合历年规律，年份未加载时会先加载，没有异常时返回 nil
+// 检查项包括 CoverageScore 的全部检查、调休工作日是否为周末、补休日是否为工作日、
+// 连续上班天数是否超过 7 天以及全年工作日天数是否在合理范围内
+func (c *Checker) DetectAnomalies(year int) ([]ValidationIssue, error) {
+	idx, err := c.index(year)
+	if err != nil {
+		return nil, err
+	}
+	return detectAnomalies(idx), nil
+}
+
+// detectAnomalies 检查年份索引是否符合历年规律
+func detectAnomalies(idx *yearIndex) []ValidationIssue {
+	issues := evaluateCoverage(idx.year, idx.base.view()).Issues
+	report := func(path, format string, args ...any) {
+		issues = append(issues, ValidationIssue{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	workdays, run, longest := 0, 0, 0
+	var longestEnd time.Time
+	day := time.Date(idx.year, 1, 1, 0, 0, 0, 0, time.UTC)
+	for day.Year() == idx.year {
+		dayType, _ := idx.lookup(day)
+		weekend := day.Weekday() == time.Saturday || day.Weekday() == time.Sunday
+		key, label := dateKey(day), "周"+chineseWeekdays[(int(day.Weekday())+6)%7]
+		switch {
+		case dayType == DayTypeAdjustedWorkday && !weekend:
+			report("workdays."+string(key[:]), "调休工作日是%s，不是周末", label)
+		case dayType == DayTypeInLieu && weekend:
+			report("inLieuDays."+string(key[:]), "补休日是%s，本来就是休息日", label)
+		}
+
+		if dayType == DayTypeWorkday || dayType == DayTypeAdjustedWorkday {
+			workdays++
+			run++
+			if run > longest {
+				longest, longestEnd = run, day
+			}
+		} else {
+			run = 0
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	if longest > maxConsecutiveWorkdays {
+		report("workdays", "截至 %s 连续上班 %d 天，超过 %d 天", longestEnd.Format("2006-01-02"), longest, maxConsecutiveWorkdays)
+	}
+	if workdays < minYearWorkdays || workdays > maxYearWorkdays {
+		report("workdays", "全年工作日 %d 天，不在合理范围 %d ~ %d 天内", workdays, minYearWorkdays, maxYearWorkdays)
+	}
+	return issues
+}
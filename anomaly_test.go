@@ -0,0 +1,69 @@
+package cnholiday
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectAnomaliesEmbedded(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	for _, year := range embeddedYears() {
+		issues, err := checker.DetectAnomalies(year)
+		if err != nil {
+			t.Fatalf("DetectAnomalies(%d) failed: %v", year, err)
+		}
+		if len(issues) > 0 {
+			t.Errorf("DetectAnomalies(%d) = %v, want none for embedded data", year, issues)
+		}
+	}
+}
+
+func TestOnAnomaly(t *testing.T) {
+	var got []*Anomaly
+	checker := NewCheckerWithConfig(Config{
+		DisableRemote: true,
+		OnAnomaly:     func(a *Anomaly) { got = append(got, a) },
+	})
+
+	// 2026 年数据中调休工作日写到了周一，补休日写到了周日，国庆后连续上班 10 天
+	raw, err := embeddedData.ReadFile("data/2026.json")
+	if err != nil {
+		t.Fatalf("read embedded 2026: %v", err)
+	}
+	data, err := parseHolidayData(raw)
+	if err != nil {
+		t.Fatalf("parse embedded 2026: %v", err)
+	}
+	data.Workdays["2026-10-12"] = data.Workdays["2026-10-10"]
+	data.Workdays["2026-10-11"] = data.Workdays["2026-10-10"]
+	data.Workdays["2026-10-17"] = data.Workdays["2026-10-10"]
+	data.InLieuDays["2026-02-22"] = data.InLieuDays["2026-02-20"]
+	checker.Import(map[int]*HolidayData{2026: data})
+
+	if len(got) != 1 || got[0].Year != 2026 || got[0].Source != SourceImport {
+		t.Fatalf("OnAnomaly calls = %v, want one call for 2026", got)
+	}
+	want := []string{
+		"inLieuDays.2026-02-22: 补休日是周日",
+		"workdays.2026-10-12: 调休工作日是周一",
+		"workdays: 截至 2026-10-17 连续上班 10 天",
+	}
+	message := got[0].Error()
+	for _, w := range want {
+		found := false
+		for _, issue := range got[0].Issues {
+			found = found || strings.HasPrefix(issue.String(), w)
+		}
+		if !found {
+			t.Errorf("issues %v missing %q", got[0].Issues, w)
+		}
+	}
+	if !strings.Contains(message, "2026 年数据(import)") {
+		t.Errorf("Error() = %q", message)
+	}
+
+	report, ok := checker.LoadReport(2026)
+	if !ok || len(report.Anomalies) != len(got[0].Issues) {
+		t.Errorf("LoadReport.Anomalies = %v, want %v", report.Anomalies, got[0].Issues)
+	}
+}
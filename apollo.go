@@ -0,0 +1,255 @@
+package cnholiday
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ApolloConfig 携程 Apollo 配置中心的连接参数
+type ApolloConfig struct {
+	ServerURL string // Config Service 地址，例如 "http://apollo-config:8080"
+	AppID     string
+	Cluster   string // 默认为 "default"
+	Namespace string // 存放节假日数据的命名空间，默认为 "application"
+	Secret    string // 访问密钥，开启了访问密钥的应用需要设置
+	// HTTPClient 请求使用的客户端，默认为超时 90 秒的客户端(长轮询最长挂起 60 秒)
+	HTTPClient *http.Client
+	// RetryInterval 长轮询失败后的重试间隔，默认 5 秒
+	RetryInterval time.Duration
+}
+
+// Apollo 携程 Apollo 配置中心客户端，实现 ConfigCenter
+// 命名空间中的每个配置项对应 ConfigCenter 的一个键，值为年份数据的 JSON，
+// 例如在 properties 格式的命名空间中设置 ConfigCenterKey 为 "holidays.{year}"。
+// Watch 通过 Apollo 的通知长轮询接口监听命名空间的发布，仅在键的值发生变化时回调
+type Apollo struct {
+	config ApolloConfig
+}
+
+var _ ConfigCenter = (*Apollo)(nil)
+
+// NewApollo 创建 Apollo 配置中心客户端
+func NewApollo(config ApolloConfig) *Apollo {
+	config.ServerURL = strings.TrimRight(config.ServerURL, "/")
+	if config.Cluster == "" {
+		config.Cluster = "default"
+	}
+	if config.Namespace == "" {
+		config.Namespace = "application"
+	}
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 90 * time.Second}
+	}
+	if config.RetryInterval <= 0 {
+		config.RetryInterval = 5 * time.Second
+	}
+	return &Apollo{config: config}
+}
+
+// Get 实现 ConfigCenter，读取命名空间当前发布的配置项
+func (a *Apollo) Get(ctx context.Context, key string) ([]byte, error) {
+	items, err := a.configurations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := items[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s/%s", ErrKeyNotFound, a.config.Namespace, key)
+	}
+	return []byte(value), nil
+}
+
+// Watch 实现 ConfigCenter，在后台长轮询命名空间的发布通知，ctx 取消后停止
+func (a *Apollo) Watch(ctx context.Context, key string, onChange func(value []byte)) error {
+	current, err := a.Get(ctx, key)
+	if err != nil && !errors.Is(err, ErrKeyNotFound) {
+		return err
+	}
+
+	go func() {
+		notificationID := -1
+		for ctx.Err() == nil {
+			id, changed, err := a.poll(ctx, notificationID)
+			if err != nil {
+				select {
+				case <-ctx.Done():
+				case <-time.After(a.config.RetryInterval):
+				}
+				continue
+			}
+			notificationID = id
+			if !changed {
+				continue
+			}
+
+			value, err := a.Get(ctx, key)
+			switch {
+			case err == nil && !bytes.Equal(value, current):
+				current = value
+				onChange(value)
+			case errors.Is(err, ErrKeyNotFound) && current != nil:
+				current = nil
+				onChange(nil)
+			}
+		}
+	}()
+	return nil
+}
+
+// configurations 读取命名空间中的全部配置项
+func (a *Apollo) configurations(ctx context.Context) (map[string]string, error) {
+	path := fmt.Sprintf("/configs/%s/%s/%s", url.PathEscape(a.config.AppID), url.PathEscape(a.config.Cluster), url.PathEscape(a.config.Namespace))
+	resp, err := a.do(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: 命名空间 %s 不存在", ErrKeyNotFound, a.config.Namespace)
+	default:
+		return nil, fmt.Errorf("Apollo 返回 HTTP 状态码 %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Configurations map[string]string `json:"configurations"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("解析 Apollo 配置失败: %w", err)
+	}
+	return body.Configurations, nil
+}
+
+// poll 长轮询命名空间的发布通知，返回最新的通知 ID 以及是否有新的发布
+func (a *Apollo) poll(ctx context.Context, notificationID int) (int, bool, error) {
+	notifications, _ := json.Marshal([]apolloNotification{{NamespaceName: a.config.Namespace, NotificationID: notificationID}})
+	query := url.Values{
+		"appId":         {a.config.AppID},
+		"cluster":       {a.config.Cluster},
+		"notifications": {string(notifications)},
+	}
+	resp, err := a.do(ctx, "/notifications/v2?"+query.Encode())
+	if err != nil {
+		return notificationID, false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		return notificationID, false, nil
+	case http.StatusOK:
+	default:
+		return notificationID, false, fmt.Errorf("Apollo 返回 HTTP 状态码 %d", resp.StatusCode)
+	}
+
+	var result []apolloNotification
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return notificationID, false, fmt.Errorf("解析 Apollo 通知失败: %w", err)
+	}
+	for _, n := range result {
+		if n.NamespaceName == a.config.Namespace && n.NotificationID != notificationID {
+			return n.NotificationID, true, nil
+		}
+	}
+	return notificationID, false, nil
+}
+
+// apolloNotification Apollo 通知接口中的一条记录
+type apolloNotification struct {
+	NamespaceName  string `json:"namespaceName"`
+	NotificationID int    `json:"notificationId"`
+}
+
+// do 发送 GET 请求，设置了 Secret 时按 Apollo 的访问密钥规则签名
+func (a *Apollo) do(ctx context.Context, pathWithQuery string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.config.ServerURL+pathWithQuery, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	if a.config.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		req.Header.Set("Authorization", fmt.Sprintf("Apollo %s:%s", a.config.AppID, apolloSignature(a.config.Secret, timestamp, pathWithQuery)))
+		req.Header.Set("Timestamp", timestamp)
+	}
+
+	resp, err := a.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("请求 Apollo 失败: %w", err)
+	}
+	return resp, nil
+}
+
+// apolloSignature 计算 Apollo 访问密钥签名：Base64(HMAC-SHA1(secret, timestamp + "\n" + pathWithQuery))
+func apolloSignature(secret, timestamp, pathWithQuery string) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	io.WriteString(mac, timestamp+"\n"+pathWithQuery)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// apolloSettingPrefix 命名空间中检查器配置项的前缀
+const apolloSettingPrefix = "cnholiday."
+
+// LoadConfig 读取命名空间中以 "cnholiday." 开头的检查器配置，覆盖 base 中的对应字段后返回，
+// 支持 cdnBaseURL、remoteURLTemplate、disableRemote、localDataDir、remoteTimeout(如 "5s")、
+// strictValidation、heuristicFallback、asyncRefresh、minYear、maxYear。
+// 配置只在创建检查器时读取一次，修改后需要重新创建检查器
+func (a *Apollo) LoadConfig(ctx context.Context, base Config) (Config, error) {
+	items, err := a.configurations(ctx)
+	if err != nil {
+		return base, err
+	}
+
+	config := base
+	for key, value := range items {
+		name, ok := strings.CutPrefix(key, apolloSettingPrefix)
+		if !ok {
+			continue
+		}
+		if err := applyApolloSetting(&config, name, value); err != nil {
+			return base, fmt.Errorf("配置项 %s 无效: %w", key, err)
+		}
+	}
+	return config, nil
+}
+
+// applyApolloSetting 将单个配置项写入 config，未知的配置项会被忽略
+func applyApolloSetting(config *Config, name, value string) error {
+	var err error
+	switch name {
+	case "cdnBaseURL":
+		config.CDNBaseURL = value
+	case "remoteURLTemplate":
+		config.RemoteURLTemplate = value
+	case "localDataDir":
+		config.LocalDataDir = value
+	case "disableRemote":
+		config.DisableRemote, err = strconv.ParseBool(value)
+	case "strictValidation":
+		config.StrictValidation, err = strconv.ParseBool(value)
+	case "heuristicFallback":
+		config.HeuristicFallback, err = strconv.ParseBool(value)
+	case "asyncRefresh":
+		config.AsyncRefresh, err = strconv.ParseBool(value)
+	case "remoteTimeout":
+		config.RemoteTimeout, err = time.ParseDuration(value)
+	case "minYear":
+		config.MinYear, err = strconv.Atoi(value)
+	case "maxYear":
+		config.MaxYear, err = strconv.Atoi(value)
+	}
+	return err
+}
@@ -0,0 +1,146 @@
+package cnholiday
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeApollo 模拟 Apollo Config Service 的配置与通知接口
+type fakeApollo struct {
+	mu      sync.Mutex
+	items   map[string]string
+	id      int
+	changed chan struct{}
+	secret  string
+	badSign int
+}
+
+func newFakeApollo(items map[string]string) *fakeApollo {
+	return &fakeApollo{items: items, id: 1, changed: make(chan struct{})}
+}
+
+// publish 发布新的配置并唤醒挂起的长轮询
+func (f *fakeApollo) publish(key, value string) {
+	f.mu.Lock()
+	f.items[key] = value
+	f.id++
+	close(f.changed)
+	f.changed = make(chan struct{})
+	f.mu.Unlock()
+}
+
+func (f *fakeApollo) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if f.secret != "" {
+		want := "Apollo app:" + apolloSignature(f.secret, r.Header.Get("Timestamp"), r.URL.RequestURI())
+		if r.Header.Get("Authorization") != want {
+			f.mu.Lock()
+			f.badSign++
+			f.mu.Unlock()
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+	}
+
+	switch r.URL.Path {
+	case "/configs/app/default/holiday":
+		f.mu.Lock()
+		body, _ := json.Marshal(map[string]any{"configurations": f.items})
+		f.mu.Unlock()
+		w.Write(body)
+	case "/notifications/v2":
+		var notifications []apolloNotification
+		json.Unmarshal([]byte(r.URL.Query().Get("notifications")), &notifications)
+		f.mu.Lock()
+		id, changed := f.id, f.changed
+		f.mu.Unlock()
+		if len(notifications) == 1 && notifications[0].NotificationID == id {
+			select {
+			case <-changed:
+			case <-r.Context().Done():
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+		f.mu.Lock()
+		body, _ := json.Marshal([]apolloNotification{{NamespaceName: "holiday", NotificationID: f.id}})
+		f.mu.Unlock()
+		w.Write(body)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestApolloSource(t *testing.T) {
+	fake := newFakeApollo(map[string]string{
+		"holidays.2099":            `{"holidays":{"2099-01-01":"元旦"},"workdays":{},"inLieuDays":{}}`,
+		"cnholiday.disableRemote":  "true",
+		"cnholiday.remoteTimeout":  "3s",
+		"cnholiday.unknownSetting": "ignored",
+	})
+	fake.secret = "s3cr3t"
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	apollo := NewApollo(ApolloConfig{ServerURL: server.URL, AppID: "app", Namespace: "holiday", Secret: "s3cr3t"})
+	config, err := apollo.LoadConfig(context.Background(), Config{LocalDataDir: "./data"})
+	if err != nil {
+		t.Fatalf("LoadConfig failed: %v", err)
+	}
+	if !config.DisableRemote || config.RemoteTimeout != 3*time.Second || config.LocalDataDir != "./data" {
+		t.Errorf("LoadConfig = %+v", config)
+	}
+
+	config = Config{DisableRemote: true, ConfigCenter: apollo, ConfigCenterKey: "holidays.{year}"}
+	checker := NewCheckerWithConfig(config)
+	if err := checker.LoadYear(2099); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if _, err := apollo.Get(context.Background(), "holidays.2098"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Get missing key = %v, want ErrKeyNotFound", err)
+	}
+	if fake.badSign != 0 {
+		t.Errorf("%d requests had an invalid signature", fake.badSign)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan ChangeEvent, 1)
+	checker.Subscribe(func(e ChangeEvent) { changes <- e })
+	if err := checker.WatchConfigCenter(ctx, 2099); err != nil {
+		t.Fatalf("WatchConfigCenter failed: %v", err)
+	}
+
+	// 发布与监听键无关的配置不触发更新
+	fake.publish("other", "1")
+	fake.publish("holidays.2099", `{"holidays":{"2099-01-01":"元旦","2099-01-02":"元旦"},"workdays":{},"inLieuDays":{}}`)
+	select {
+	case e := <-changes:
+		if e.Year != 2099 || e.Source != SourceConfigCenter || len(e.Changes) != 1 {
+			t.Errorf("change event = %+v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the Apollo release to be applied")
+	}
+}
+
+func TestApplyApolloSetting(t *testing.T) {
+	var config Config
+	for name, value := range map[string]string{"minYear": "2010", "maxYear": "2030", "strictValidation": "1", "cdnBaseURL": "https://cdn"} {
+		if err := applyApolloSetting(&config, name, value); err != nil {
+			t.Fatalf("applyApolloSetting(%s) failed: %v", name, err)
+		}
+	}
+	if config.MinYear != 2010 || config.MaxYear != 2030 || !config.StrictValidation || config.CDNBaseURL != "https://cdn" {
+		t.Errorf("config = %+v", config)
+	}
+	if err := applyApolloSetting(&config, "remoteTimeout", strconv.Itoa(5)); err == nil {
+		t.Error("expected error for duration without unit")
+	}
+}
@@ -0,0 +1,86 @@
+package cnholiday
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNoDataAsOf 指定时间点尚未加载过该年份的数据
+// 变更记录只追加不丢弃，因此只在该时间点之前确实没有加载过时返回
+var ErrNoDataAsOf = errors.New("指定时间点没有该年份的数据")
+
+// PointInTimeView 按历史某一时刻的数据回答查询的只读视图
+// 只使用 History 中记录的数据，不会触发加载
+type PointInTimeView struct {
+	checker *Checker
+	at      time.Time
+}
+
+// AsOf 返回按 t 时刻生效数据回答查询的视图，用于追溯重算时使用当时的日历
+func (c *Checker) AsOf(t time.Time) *PointInTimeView {
+	return &PointInTimeView{checker: c, at: t}
+}
+
+// Time 返回视图对应的时间点
+func (v *PointInTimeView) Time() time.Time {
+	return v.at
+}
+
+// index 返回 v.at 时刻生效的年份索引
+func (v *PointInTimeView) index(year int) (*yearIndex, error) {
+	v.checker.mu.RLock()
+	defer v.checker.mu.RUnlock()
+
+	history := v.checker.history[year]
+	for i := len(history) - 1; i >= 0; i-- {
+		if !history[i].Time.After(v.at) {
+			return history[i].index, nil
+		}
+	}
+	return nil, fmt.Errorf("%d 年 @ %s: %w", year, v.at.Format(time.RFC3339), ErrNoDataAsOf)
+}
+
+// Classify 返回指定日期在该时刻的类型及节日名称
+func (v *PointInTimeView) Classify(date time.Time) (DayType, string, error) {
+	idx, err := v.index(date.Year())
+	if err != nil {
+		return DayTypeWorkday, "", err
+	}
+	dayType, name := idx.lookup(date)
+	return dayType, name, nil
+}
+
+// IsHoliday 判断指定日期在该时刻是否是节假日(休息日)
+func (v *PointInTimeView) IsHoliday(date time.Time) (bool, string, error) {
+	dayType, name, err := v.Classify(date)
+	if err != nil {
+		return false, "", err
+	}
+	if dayType == DayTypeWeekend {
+		name = "周末"
+	}
+	return !dayType.IsWorkday(), name, nil
+}
+
+// IsWorkday 判断指定日期在该时刻是否是工作日
+func (v *PointInTimeView) IsWorkday(date time.Time) (bool, error) {
+	dayType, _, err := v.Classify(date)
+	if err != nil {
+		return false, err
+	}
+	return dayType.IsWorkday(), nil
+}
+
+// GetHolidayInfo 获取指定日期在该时刻的详细信息
+func (v *PointInTimeView) GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
+	idx, err := v.index(date.Year())
+	if err != nil {
+		return nil, err
+	}
+	dayType, name := idx.lookup(date)
+	info := &HolidayInfo{}
+	fillHolidayInfo(info, date, dayType, name)
+	info.Source, info.DataVersion = idx.source, idx.version
+	return info, nil
+}
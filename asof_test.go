@@ -0,0 +1,56 @@
+package cnholiday
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAsOf(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC) // 周一
+
+	beforeLoad := time.Now()
+	if err := checker.LoadYearFromJSON(2026, []byte(emptyYearJSON)); err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+	afterLoad := checker.History(2026)[0].Time
+
+	time.Sleep(time.Millisecond)
+	if _, err := checker.PatchYear(2026, &HolidayData{Holidays: map[string]string{"2026-01-05": "临时放假"}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+
+	// 修订前的视图仍为工作日
+	isWorkday, err := checker.AsOf(afterLoad).IsWorkday(date)
+	if err != nil || !isWorkday {
+		t.Errorf("AsOf(load).IsWorkday = %v, %v; want true", isWorkday, err)
+	}
+
+	// 当前视图为节假日
+	isHoliday, name, err := checker.AsOf(time.Now()).IsHoliday(date)
+	if err != nil || !isHoliday || name != "临时放假" {
+		t.Errorf("AsOf(now).IsHoliday = %v, %q, %v", isHoliday, name, err)
+	}
+
+	// 加载之前没有数据
+	if _, err := checker.AsOf(beforeLoad.Add(-time.Second)).IsWorkday(date); !errors.Is(err, ErrNoDataAsOf) {
+		t.Errorf("expected ErrNoDataAsOf, got %v", err)
+	}
+
+	// 之后的多次修订不影响最早时刻的视图
+	for i := range 100 {
+		if _, err := checker.PatchYear(2026, &HolidayData{Holidays: map[string]string{"2026-01-05": fmt.Sprintf("修订%d", i)}}); err != nil {
+			t.Fatalf("PatchYear failed: %v", err)
+		}
+	}
+	if isWorkday, err := checker.AsOf(afterLoad).IsWorkday(date); err != nil || !isWorkday {
+		t.Errorf("AsOf(load).IsWorkday after 100 patches = %v, %v; want true", isWorkday, err)
+	}
+
+	info, err := checker.AsOf(time.Now()).GetHolidayInfo(date)
+	if err != nil || !info.IsHoliday {
+		t.Errorf("AsOf(now).GetHolidayInfo = %+v, %v", info, err)
+	}
+}
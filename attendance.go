@@ -0,0 +1,93 @@
+package cnholiday
+
+import (
+	"time"
+)
+
+// 劳社部发〔2008〕3号规定的月平均天数
+const (
+	MonthlyPayDays         = 21.75 // 月计薪天数 = (365 - 104) / 12
+	AverageMonthlyWorkdays = 20.83 // 月平均工作天数 = (365 - 104 - 11) / 12
+)
+
+// AttendancePolicy 出勤规则
+type AttendancePolicy struct {
+	// Weekend 休息的星期，为空时为周六和周日；调休工作日始终需要上班
+	Weekend []time.Weekday
+	// Shift 轮班模式，非空时按轮班判断是否上班，忽略 Weekend
+	Shift *ShiftPattern
+}
+
+// Attendance 一段时间内的出勤统计
+// 每天只计入 Workdays、StatutoryHolidays、RestDays 中的一项
+type Attendance struct {
+	TotalDays         int // 总天数
+	Workdays          int // 应出勤天数
+	StatutoryHolidays int // 不出勤的法定节假日天数(计薪)
+	RestDays          int // 其他休息日天数(不计薪)
+}
+
+// PaidDays 计薪天数 = 应出勤天数 + 法定节假日天数
+func (a *Attendance) PaidDays() int {
+	return a.Workdays + a.StatutoryHolidays
+}
+
+// ExpectedAttendance 统计 [start, end] 闭区间内按规则应出勤的天数，其他边界使用 ExpectedAttendanceIn
+func (c *Checker) ExpectedAttendance(start, end time.Time, policy AttendancePolicy) (*Attendance, error) {
+	return c.ExpectedAttendanceIn(DateRange{Start: start, End: end}, policy)
+}
+
+// ExpectedAttendanceIn 统计区间内按规则应出勤的天数，例如按 [入职日, 离职日) 计算时离职日不计
+func (c *Checker) ExpectedAttendanceIn(r DateRange, policy AttendancePolicy) (*Attendance, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	weekend := policy.Weekend
+	if len(weekend) == 0 {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+
+	result := &Attendance{}
+	for date := range r.All() {
+		dayType, name, err := c.Classify(date)
+		if err != nil {
+			return nil, err
+		}
+
+		var working bool
+		switch {
+		case policy.Shift != nil:
+			if working, err = c.IsShiftWorking(policy.Shift, date); err != nil {
+				return nil, err
+			}
+		case dayType == DayTypeAdjustedWorkday:
+			working = true
+		case dayType == DayTypePublicHoliday || dayType == DayTypeInLieu:
+			working = false
+		default:
+			working = !containsWeekday(weekend, date.Weekday())
+		}
+
+		result.TotalDays++
+		switch {
+		case working:
+			result.Workdays++
+		case isStatutoryHoliday(dayType, name, date):
+			result.StatutoryHolidays++
+		default:
+			result.RestDays++
+		}
+	}
+	return result, nil
+}
+
+// containsWeekday 判断 weekdays 中是否包含 day
+func containsWeekday(weekdays []time.Weekday, day time.Weekday) bool {
+	for _, w := range weekdays {
+		if w == day {
+			return true
+		}
+	}
+	return false
+}
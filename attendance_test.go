@@ -0,0 +1,79 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpectedAttendance(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	// 2026 年 10 月：1-7 日国庆放假(含补休 6、7 日)，10 日周六调休上班
+	got, err := checker.ExpectedAttendance(start, end, AttendancePolicy{})
+	if err != nil {
+		t.Fatalf("ExpectedAttendance failed: %v", err)
+	}
+	want := Attendance{TotalDays: 31, Workdays: 18, StatutoryHolidays: 3, RestDays: 10}
+	if *got != want {
+		t.Errorf("ExpectedAttendance() = %+v, want %+v", *got, want)
+	}
+	if got.PaidDays() != 21 {
+		t.Errorf("PaidDays() = %d, want 21", got.PaidDays())
+	}
+
+	// 10 月 3 日是落在周六的法定节假日，4 日是假期内的周日
+	got, err = checker.ExpectedAttendance(start, start.AddDate(0, 0, 3), AttendancePolicy{})
+	if err != nil {
+		t.Fatalf("ExpectedAttendance failed: %v", err)
+	}
+	if want := (Attendance{TotalDays: 4, StatutoryHolidays: 3, RestDays: 1}); *got != want {
+		t.Errorf("ExpectedAttendance(10-01 ~ 10-04) = %+v, want %+v", *got, want)
+	}
+
+	// 全年 13 天法定节假日，与落在周末还是周一至周五无关
+	got, err = checker.ExpectedAttendance(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), AttendancePolicy{})
+	if err != nil {
+		t.Fatalf("ExpectedAttendance failed: %v", err)
+	}
+	if got.StatutoryHolidays != 13 {
+		t.Errorf("StatutoryHolidays in 2026 = %d, want 13", got.StatutoryHolidays)
+	}
+
+	// 按 [入职日, 离职日) 计算，离职日 10 月 31 日(周六)不计
+	got, err = checker.ExpectedAttendanceIn(DateRange{Start: start, End: end, Bounds: BoundsClosedOpen}, AttendancePolicy{})
+	if err != nil {
+		t.Fatalf("ExpectedAttendanceIn failed: %v", err)
+	}
+	if got.TotalDays != 30 || got.Workdays != 18 || got.RestDays != 9 {
+		t.Errorf("ExpectedAttendanceIn([10-01, 10-31)) = %+v", *got)
+	}
+
+	// 单休：仅周日休息
+	got, err = checker.ExpectedAttendance(start, end, AttendancePolicy{Weekend: []time.Weekday{time.Sunday}})
+	if err != nil {
+		t.Fatalf("ExpectedAttendance failed: %v", err)
+	}
+	if got.Workdays != 21 {
+		t.Errorf("Workdays with Sunday-only weekend = %d, want 21", got.Workdays)
+	}
+
+	// 轮班：做一休一，节假日照常上班
+	shift, _ := ParseShiftPattern(start, "10")
+	got, err = checker.ExpectedAttendance(start, end, AttendancePolicy{Shift: shift})
+	if err != nil {
+		t.Fatalf("ExpectedAttendance failed: %v", err)
+	}
+	if got.Workdays != 16 || got.StatutoryHolidays != 1 || got.RestDays != 14 {
+		t.Errorf("ExpectedAttendance with shift = %+v", *got)
+	}
+
+	if _, err := checker.ExpectedAttendance(end, start, AttendancePolicy{}); err == nil {
+		t.Error("expected error when end is before start")
+	}
+}
@@ -0,0 +1,132 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+// newBenchChecker 返回已加载 2024-2026 年内置数据的离线检查器
+func newBenchChecker(b *testing.B) *Checker {
+	b.Helper()
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	for _, year := range []int{2024, 2025, 2026} {
+		if err := checker.LoadYear(year); err != nil {
+			b.Fatalf("LoadYear(%d) failed: %v", year, err)
+		}
+	}
+	return checker
+}
+
+func BenchmarkIsHolidayCached(b *testing.B) {
+	checker := newBenchChecker(b)
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		checker.IsHoliday(date)
+	}
+}
+
+func BenchmarkIsHolidayUncached(b *testing.B) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		checker.ClearYear(2026)
+		checker.IsHoliday(date)
+	}
+}
+
+func BenchmarkIsHolidayFast(b *testing.B) {
+	checker := newBenchChecker(b)
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		checker.IsHolidayFast(date)
+	}
+}
+
+func BenchmarkGetHolidayInfo(b *testing.B) {
+	checker := newBenchChecker(b)
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		checker.GetHolidayInfo(date)
+	}
+}
+
+// BenchmarkBatchYear 逐日查询一整年
+func BenchmarkBatchYear(b *testing.B) {
+	checker := newBenchChecker(b)
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)
+	dates := make([]time.Time, 365)
+	for i := range dates {
+		dates[i] = start.AddDate(0, 0, i)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, date := range dates {
+			checker.IsWorkday(date)
+		}
+	}
+}
+
+// BenchmarkYearInfo 一次生成一整年的节假日信息
+func BenchmarkYearInfo(b *testing.B) {
+	checker := newBenchChecker(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		checker.YearInfo(2026)
+	}
+}
+
+// BenchmarkCountWorkdaysBetween 跨三年的区间统计
+func BenchmarkCountWorkdaysBetween(b *testing.B) {
+	checker := newBenchChecker(b)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.Local)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		checker.CountWorkdaysBetween(start, end)
+	}
+}
+
+func BenchmarkNextHoliday(b *testing.B) {
+	checker := newBenchChecker(b)
+	date := time.Date(2024, 2, 20, 0, 0, 0, 0, time.Local)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		checker.NextHoliday(date)
+	}
+}
+
+func BenchmarkConcurrentReaders(b *testing.B) {
+	checker := newBenchChecker(b)
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			checker.IsHoliday(date)
+		}
+	})
+}
+
+func BenchmarkGetHolidayInfoInto(b *testing.B) {
+	checker := newBenchChecker(b)
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	var info HolidayInfo
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		checker.GetHolidayInfoInto(date, &info)
+	}
+}
+
+func BenchmarkMemoIsWorkday(b *testing.B) {
+	memo := WithMemo(newBenchChecker(b), 0)
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		memo.IsWorkday(date)
+	}
+}
@@ -0,0 +1,97 @@
+package cnholiday
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen 远程数据源处于熔断状态，本次请求被跳过
+var ErrCircuitOpen = errors.New("远程数据源已熔断，跳过请求")
+
+// circuitBreaker 远程数据源熔断器
+// 连续失败达到 threshold 次后进入熔断状态，cooldown 期间直接跳过远程请求；
+// 冷却结束后进入半开状态，只放行一个探测请求，其结果返回前其他请求仍被跳过；
+// 探测失败则立即重新熔断，成功则恢复正常
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int       // 连续失败次数
+	openUntil time.Time // 熔断截止时间
+	probing   bool      // 半开状态下已放行探测请求，尚未得到结果
+	now       func() time.Time
+}
+
+// newCircuitBreaker 创建熔断器，threshold <= 0 时不启用熔断
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow 判断当前是否允许请求远程数据源
+func (b *circuitBreaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true
+	}
+	if b.probing || b.now().Before(b.openUntil) {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// release 放弃 allow 放行的请求，不记录结果，半开状态下允许重新探测
+// 用于请求被限流或被调用方取消等没有实际访问远程数据源的情况
+func (b *circuitBreaker) release() {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	b.probing = false
+	b.mu.Unlock()
+}
+
+// success 记录一次成功请求，重置失败计数
+func (b *circuitBreaker) success() {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	b.failures = 0
+	b.openUntil = time.Time{}
+	b.probing = false
+	b.mu.Unlock()
+}
+
+// failure 记录一次失败请求，达到阈值时进入熔断
+func (b *circuitBreaker) failure() {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	b.failures++
+	b.probing = false
+	if b.failures >= b.threshold {
+		b.openUntil = b.now().Add(b.cooldown)
+	}
+	b.mu.Unlock()
+}
+
+// countsAsFailure 判断远程请求的错误是否计入熔断统计
+// 只有网络错误和 5xx 说明数据源不可用；404 等 4xx 说明数据源正常，例如下一年的数据尚未发布
+func countsAsFailure(err error) bool {
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+	return true
+}
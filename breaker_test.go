@@ -0,0 +1,157 @@
+package cnholiday
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newCircuitBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	b.failure()
+	if !b.allow() {
+		t.Fatal("breaker should stay closed below threshold")
+	}
+
+	b.failure()
+	if b.allow() {
+		t.Fatal("breaker should open after reaching threshold")
+	}
+
+	// 冷却结束后放行
+	now = now.Add(time.Minute)
+	if !b.allow() {
+		t.Fatal("breaker should allow requests after cooldown")
+	}
+
+	// 冷却后再次失败立即熔断
+	b.failure()
+	if b.allow() {
+		t.Fatal("breaker should reopen on failure after cooldown")
+	}
+
+	now = now.Add(time.Minute)
+	b.success()
+	b.failure()
+	if !b.allow() {
+		t.Fatal("success should reset failure count")
+	}
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newCircuitBreaker(1, time.Minute)
+	b.now = func() time.Time { return now }
+
+	b.failure()
+	now = now.Add(time.Minute)
+
+	// 半开状态只放行一个探测请求
+	if !b.allow() {
+		t.Fatal("breaker should allow a probe after cooldown")
+	}
+	if b.allow() {
+		t.Fatal("breaker should reject other requests while probing")
+	}
+
+	// 探测被放弃后可以重新探测
+	b.release()
+	if !b.allow() {
+		t.Fatal("breaker should allow a new probe after release")
+	}
+	b.success()
+	if !b.allow() || !b.allow() {
+		t.Fatal("breaker should close after a successful probe")
+	}
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	b := newCircuitBreaker(0, time.Minute)
+	for i := 0; i < 10; i++ {
+		b.failure()
+	}
+	if !b.allow() {
+		t.Error("disabled breaker should always allow requests")
+	}
+}
+
+func TestRemoteCircuitBreaker(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:       server.URL,
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Hour,
+	})
+
+	// 远程失败后回退到嵌入数据
+	for i := 0; i < 5; i++ {
+		checker.ClearYear(2026)
+		if err := checker.LoadYear(2026); err != nil {
+			t.Fatalf("LoadYear failed: %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("remote requests = %d, want 2", got)
+	}
+}
+
+func TestRemoteTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:    server.URL,
+		RemoteTimeout: 20 * time.Millisecond,
+	})
+
+	start := time.Now()
+	if _, err := checker.loadYearFromRemote(context.Background(), 2026); err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Errorf("remote request took %s, timeout not applied", elapsed)
+	}
+}
+
+func TestRemoteNotFoundDoesNotTripBreaker(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:       server.URL,
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Hour,
+	})
+
+	// 尚未发布的年份返回 404，不影响其他年份的远程加载
+	for i := 0; i < 5; i++ {
+		if _, err := checker.loadYearFromRemote(context.Background(), 2099); err == nil {
+			t.Fatal("expected error for unpublished year")
+		}
+	}
+	if got := atomic.LoadInt32(&requests); got != 5 {
+		t.Errorf("remote requests = %d, want 5", got)
+	}
+	if !checker.breaker.allow() {
+		t.Error("404 responses should not open the breaker")
+	}
+}
@@ -0,0 +1,95 @@
+package cnholiday
+
+import (
+	"slices"
+	"time"
+)
+
+// TimePoint 时间序列中的一个数据点
+type TimePoint struct {
+	Time  time.Time
+	Value float64
+}
+
+// DayBucket 按工作日聚合的数据桶
+type DayBucket struct {
+	Day                time.Time // 工作日当天零点，时区与数据点一致
+	BusinessDayOfMonth int       // 当月第几个工作日，用于对比不同月份"同一工作日"的指标；按 RollNone 聚合的休息日为 0
+	Sum                float64
+	Count              int
+}
+
+// Mean 返回桶内数据点的平均值，空桶返回 0
+func (b DayBucket) Mean() float64 {
+	if b.Count == 0 {
+		return 0
+	}
+	return b.Sum / float64(b.Count)
+}
+
+// BucketByBusinessDay 将时间序列按工作日聚合，休息日的数据点按 conv 处理：
+// RollPreceding 并入前一个工作日，RollFollowing 并入下一个工作日，RollSkip 丢弃，RollNone 单独成桶。
+// 数据点按所在时区的日期归属，返回的桶按日期升序排列，不包含没有数据点的日期
+func (c *Checker) BucketByBusinessDay(points []TimePoint, conv RollConvention) ([]DayBucket, error) {
+	targets := make(map[time.Time]time.Time) // 自然日 -> 所属工作日，避免重复查询
+	buckets := make(map[time.Time]*DayBucket)
+
+	for _, p := range points {
+		day := truncateDay(p.Time)
+		target, ok := targets[day]
+		if !ok {
+			rolled, keep, err := c.Roll(day, conv)
+			if err != nil {
+				return nil, err
+			}
+			if !keep {
+				rolled = time.Time{}
+			}
+			targets[day], target = rolled, rolled
+		}
+		if target.IsZero() {
+			continue
+		}
+
+		bucket := buckets[target]
+		if bucket == nil {
+			bucket = &DayBucket{Day: target}
+			buckets[target] = bucket
+		}
+		bucket.Sum += p.Value
+		bucket.Count++
+	}
+
+	result := make([]DayBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		result = append(result, *bucket)
+	}
+	slices.SortFunc(result, func(a, b DayBucket) int { return a.Day.Compare(b.Day) })
+
+	// 同一个月的桶共用月初的计数起点，依次累加相邻桶之间的工作日数
+	var prev time.Time
+	var prevOrdinal int
+	for i := range result {
+		day := result[i].Day
+		isWorkday, err := c.IsWorkday(day)
+		if err != nil {
+			return nil, err
+		}
+		if !isWorkday {
+			continue
+		}
+
+		from := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+		base := 0
+		if !prev.IsZero() && prev.Year() == day.Year() && prev.Month() == day.Month() {
+			from, base = prev.AddDate(0, 0, 1), prevOrdinal
+		}
+		count, err := c.CountWorkdaysBetween(from, day)
+		if err != nil {
+			return nil, err
+		}
+		result[i].BusinessDayOfMonth = base + count
+		prev, prevOrdinal = day, result[i].BusinessDayOfMonth
+	}
+	return result, nil
+}
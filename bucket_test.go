@@ -0,0 +1,79 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucketByBusinessDay(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	// 2026-09-28 ~ 2026-10-12 每天 10 点一个数据点，按倒序提供
+	var points []TimePoint
+	for day := time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC); day.Day() != 27; day = day.AddDate(0, 0, -1) {
+		points = append(points, TimePoint{Time: day, Value: float64(day.Day())})
+	}
+
+	type want struct {
+		day         string
+		count       int
+		businessDay int
+	}
+	sep30, err := checker.CountWorkdaysBetween(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("CountWorkdaysBetween failed: %v", err)
+	}
+
+	tests := []struct {
+		conv RollConvention
+		want []want
+	}{
+		// 国庆假期并入 9 月 30 日，10 月 11 日(周日)并入 10 月 10 日(调休上班)
+		{RollPreceding, []want{
+			{"2026-09-28", 1, sep30 - 2}, {"2026-09-29", 1, sep30 - 1}, {"2026-09-30", 8, sep30},
+			{"2026-10-08", 1, 1}, {"2026-10-09", 1, 2}, {"2026-10-10", 2, 3}, {"2026-10-12", 1, 4},
+		}},
+		{RollFollowing, []want{
+			{"2026-09-28", 1, sep30 - 2}, {"2026-09-29", 1, sep30 - 1}, {"2026-09-30", 1, sep30},
+			{"2026-10-08", 8, 1}, {"2026-10-09", 1, 2}, {"2026-10-10", 1, 3}, {"2026-10-12", 2, 4},
+		}},
+		{RollSkip, []want{
+			{"2026-09-28", 1, sep30 - 2}, {"2026-09-29", 1, sep30 - 1}, {"2026-09-30", 1, sep30},
+			{"2026-10-08", 1, 1}, {"2026-10-09", 1, 2}, {"2026-10-10", 1, 3}, {"2026-10-12", 1, 4},
+		}},
+	}
+	for _, tt := range tests {
+		buckets, err := checker.BucketByBusinessDay(points, tt.conv)
+		if err != nil {
+			t.Fatalf("BucketByBusinessDay(%d) failed: %v", tt.conv, err)
+		}
+		if len(buckets) != len(tt.want) {
+			t.Fatalf("conv %d: got %d buckets, want %d: %+v", tt.conv, len(buckets), len(tt.want), buckets)
+		}
+		for i, w := range tt.want {
+			b := buckets[i]
+			if b.Day.Format("2006-01-02") != w.day || b.Count != w.count || b.BusinessDayOfMonth != w.businessDay {
+				t.Errorf("conv %d: bucket %d = %s count %d day %d, want %+v", tt.conv, i, b.Day.Format("2006-01-02"), b.Count, b.BusinessDayOfMonth, w)
+			}
+		}
+	}
+
+	buckets, _ := checker.BucketByBusinessDay(points, RollPreceding)
+	// 9 月 30 日 + 10 月 1~7 日
+	if got := buckets[2].Sum; got != 30+1+2+3+4+5+6+7 {
+		t.Errorf("Sum = %v, want 58", got)
+	}
+	if got := buckets[2].Mean(); got != 58.0/8 {
+		t.Errorf("Mean = %v", got)
+	}
+
+	// RollNone 时休息日单独成桶，不计工作日序号
+	buckets, _ = checker.BucketByBusinessDay(points, RollNone)
+	if len(buckets) != len(points) || buckets[3].BusinessDayOfMonth != 0 {
+		t.Errorf("RollNone buckets = %+v", buckets)
+	}
+
+	if _, err := checker.BucketByBusinessDay([]TimePoint{{Time: time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)}}, RollPreceding); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
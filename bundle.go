@@ -0,0 +1,268 @@
+package cnholiday
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// bundleManifestName 数据包中的清单文件
+	bundleManifestName = "manifest.json"
+	// bundleSignatureName 数据包中清单文件的 Ed25519 签名，内容为 64 字节的原始签名
+	bundleSignatureName = "manifest.sig"
+	// maxBundleSize 数据包的最大字节数，防止异常数据耗尽内存
+	maxBundleSize = 64 << 20
+)
+
+// bundleFileRe 数据包中年份数据文件的名称
+var bundleFileRe = regexp.MustCompile(`^(\d{4})\.json$`)
+
+// zipMagic zip 文件的魔数
+var zipMagic = []byte("PK\x03\x04")
+
+// ErrBundleSignature 数据包清单的签名不是由 Config.BundlePublicKeys 中的任何一个公钥对应的私钥签署的
+var ErrBundleSignature = errors.New("数据包签名无效")
+
+// BundleManifest 数据包的清单，列出包中每个年份数据文件的 SHA-256 摘要
+// 签名覆盖 manifest.json 的原始字节，清单中的摘要保证数据文件未被篡改
+type BundleManifest struct {
+	Version string            `json:"version"` // 发布方定义的版本号，例如 "2026.1"
+	Created time.Time         `json:"created"`
+	Files   map[string]string `json:"files"` // 文件名({year}.json) -> SHA-256 十六进制摘要
+}
+
+// Years 返回清单包含的年份，按升序排列
+func (m *BundleManifest) Years() []int {
+	years := make([]int, 0, len(m.Files))
+	for name := range m.Files {
+		if match := bundleFileRe.FindStringSubmatch(name); match != nil {
+			year, _ := strconv.Atoi(match[1])
+			years = append(years, year)
+		}
+	}
+	slices.Sort(years)
+	return years
+}
+
+// WriteBundle 将多个年份的数据写为 tar.gz 数据包，包含 {year}.json、清单 manifest.json 及其签名 manifest.sig，
+// 用于通过制品库而不是在线地址分发审核过的数据，使用方通过 LoadBundle 校验后加载
+func WriteBundle(w io.Writer, key ed25519.PrivateKey, version string, data map[int]*HolidayData) error {
+	if len(key) != ed25519.PrivateKeySize {
+		return errors.New("签名私钥无效")
+	}
+	if len(data) == 0 {
+		return errors.New("数据包中没有任何年份")
+	}
+
+	manifest := BundleManifest{Version: version, Created: time.Now().UTC(), Files: make(map[string]string, len(data))}
+	files := make(map[string][]byte, len(data)+2)
+	for year, yearData := range data {
+		if yearData == nil {
+			return fmt.Errorf("%d 年的数据为空", year)
+		}
+		versioned := *yearData
+		versioned.SchemaVersion = CurrentSchemaVersion
+		content, err := json.Marshal(&versioned)
+		if err != nil {
+			return fmt.Errorf("序列化 %d 年数据失败: %w", year, err)
+		}
+		name := fmt.Sprintf("%d.json", year)
+		sum := sha256.Sum256(content)
+		files[name], manifest.Files[name] = content, hex.EncodeToString(sum[:])
+	}
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("序列化清单失败: %w", err)
+	}
+	files[bundleManifestName] = content
+	files[bundleSignatureName] = ed25519.Sign(key, content)
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, name := range sortedKeys(files) {
+		header := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(files[name])), ModTime: manifest.Created}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("写入数据包失败: %w", err)
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return fmt.Errorf("写入数据包失败: %w", err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("写入数据包失败: %w", err)
+	}
+	return gz.Close()
+}
+
+// LoadBundle 校验并加载 WriteBundle 生成的数据包，支持 tar、tar.gz 和 zip 格式
+// 清单签名须能被 Config.BundlePublicKeys 中的任一公钥验证，包中每个文件的摘要须与清单一致，且不能包含清单之外的文件。
+// 所有年份都通过校验和解析后才写入缓存，任一年份失败时不加载任何数据
+func (c *Checker) LoadBundle(r io.Reader) (*BundleManifest, error) {
+	keys := c.settings().BundlePublicKeys
+	if len(keys) == 0 {
+		return nil, errors.New("未配置 BundlePublicKeys，无法校验数据包签名")
+	}
+
+	files, err := readBundle(r)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := verifyBundle(files, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	years := manifest.Years()
+	parsed := make([]*HolidayData, len(years))
+	for i, year := range years {
+		if parsed[i], err = c.parseYear(SourceBundle, year, files[fmt.Sprintf("%d.json", year)]); err != nil {
+			return nil, err
+		}
+	}
+	for i, year := range years {
+		c.store(year, parsed[i], SourceBundle, nil)
+	}
+	return manifest, nil
+}
+
+// LoadBundleFile 从文件校验并加载数据包，参见 LoadBundle
+func (c *Checker) LoadBundleFile(name string) (*BundleManifest, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return c.LoadBundle(f)
+}
+
+// readBundle 读取数据包中的全部文件，按文件名(不含目录)索引
+func readBundle(r io.Reader) (map[string][]byte, error) {
+	raw, err := io.ReadAll(io.LimitReader(r, maxBundleSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("读取数据包失败: %w", err)
+	}
+	if len(raw) > maxBundleSize {
+		return nil, fmt.Errorf("数据包超过 %d 字节", maxBundleSize)
+	}
+
+	files := make(map[string][]byte)
+	add := func(name string, content []byte) error {
+		name = path.Base(strings.TrimPrefix(name, "./"))
+		if _, ok := files[name]; ok {
+			return fmt.Errorf("数据包中的文件重复: %s", name)
+		}
+		files[name] = content
+		return nil
+	}
+
+	if bytes.HasPrefix(raw, zipMagic) {
+		zr, err := zip.NewReader(bytes.NewReader(raw), int64(len(raw)))
+		if err != nil {
+			return nil, fmt.Errorf("读取 zip 数据包失败: %w", err)
+		}
+		for _, f := range zr.File {
+			if f.FileInfo().IsDir() {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, fmt.Errorf("读取 %s 失败: %w", f.Name, err)
+			}
+			content, err := io.ReadAll(io.LimitReader(rc, maxDecompressedSize+1))
+			rc.Close()
+			if err != nil {
+				return nil, fmt.Errorf("读取 %s 失败: %w", f.Name, err)
+			}
+			if len(content) > maxDecompressedSize {
+				return nil, fmt.Errorf("%s 超过 %d 字节", f.Name, maxDecompressedSize)
+			}
+			if err := add(f.Name, content); err != nil {
+				return nil, err
+			}
+		}
+		return files, nil
+	}
+
+	if raw, err = maybeGunzip(raw); err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(bytes.NewReader(raw))
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("读取 tar 数据包失败: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("读取 %s 失败: %w", header.Name, err)
+		}
+		if err := add(header.Name, content); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// verifyBundle 校验清单签名和每个文件的摘要，返回解析后的清单
+func verifyBundle(files map[string][]byte, keys []ed25519.PublicKey) (*BundleManifest, error) {
+	content, ok := files[bundleManifestName]
+	if !ok {
+		return nil, fmt.Errorf("数据包中缺少 %s", bundleManifestName)
+	}
+	signature, ok := files[bundleSignatureName]
+	if !ok {
+		return nil, fmt.Errorf("数据包中缺少 %s", bundleSignatureName)
+	}
+	if !slices.ContainsFunc(keys, func(key ed25519.PublicKey) bool { return ed25519.Verify(key, content, signature) }) {
+		return nil, ErrBundleSignature
+	}
+
+	var manifest BundleManifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("解析清单失败: %w", err)
+	}
+	if len(manifest.Files) == 0 {
+		return nil, errors.New("清单中没有任何年份")
+	}
+	for _, name := range sortedKeys(manifest.Files) {
+		if !bundleFileRe.MatchString(name) {
+			return nil, fmt.Errorf("清单中的文件名无效: %s", name)
+		}
+		data, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("数据包中缺少清单列出的 %s", name)
+		}
+		sum := sha256.Sum256(data)
+		if !strings.EqualFold(hex.EncodeToString(sum[:]), manifest.Files[name]) {
+			return nil, fmt.Errorf("%s 的摘要与清单不符", name)
+		}
+	}
+	for name := range files {
+		if _, ok := manifest.Files[name]; !ok && name != bundleManifestName && name != bundleSignatureName {
+			return nil, fmt.Errorf("数据包中的 %s 未在清单中列出", name)
+		}
+	}
+	return &manifest, nil
+}
@@ -0,0 +1,148 @@
+package cnholiday
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// zipBundle 按清单签名并打包为 zip，manifestFiles 为空时按 files 计算摘要
+func zipBundle(t *testing.T, key ed25519.PrivateKey, files map[string][]byte, manifestFiles map[string]string) []byte {
+	t.Helper()
+	if manifestFiles == nil {
+		manifestFiles = make(map[string]string)
+		for name, content := range files {
+			sum := sha256.Sum256(content)
+			manifestFiles[name] = hex.EncodeToString(sum[:])
+		}
+	}
+	manifest, err := json.Marshal(BundleManifest{Version: "test", Files: manifestFiles})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	all := map[string][]byte{bundleManifestName: manifest, bundleSignatureName: ed25519.Sign(key, manifest)}
+	for name, content := range files {
+		all["bundle/"+name] = content
+	}
+	for name, content := range all {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(content)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestBundleRoundTrip(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := source.LoadYears(t.Context(), 2025, 2026); err != nil {
+		t.Fatalf("LoadYears failed: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "holidays.tar.gz")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteBundle(f, key, "2026.1", source.Export()); err != nil {
+		t.Fatalf("WriteBundle failed: %v", err)
+	}
+	f.Close()
+
+	checker := NewCheckerWithConfig(Config{DisableRemote: true, BundlePublicKeys: []ed25519.PublicKey{pub}})
+	manifest, err := checker.LoadBundleFile(path)
+	if err != nil {
+		t.Fatalf("LoadBundleFile failed: %v", err)
+	}
+	if manifest.Version != "2026.1" || !reflect.DeepEqual(manifest.Years(), []int{2025, 2026}) {
+		t.Errorf("manifest = %+v, years %v", manifest, manifest.Years())
+	}
+	if report, _ := checker.LoadReport(2026); report.Source != SourceBundle {
+		t.Errorf("source = %s, want bundle", report.Source)
+	}
+	if !reflect.DeepEqual(checker.Export(), source.Export()) {
+		t.Error("bundle data differs from the exported data")
+	}
+
+	// 未信任的公钥
+	other, _, _ := ed25519.GenerateKey(nil)
+	untrusted := NewCheckerWithConfig(Config{DisableRemote: true, BundlePublicKeys: []ed25519.PublicKey{other}})
+	if _, err := untrusted.LoadBundleFile(path); !errors.Is(err, ErrBundleSignature) {
+		t.Errorf("LoadBundleFile with untrusted key error = %v, want ErrBundleSignature", err)
+	}
+	if untrusted.IsYearLoaded(2026) {
+		t.Error("years should not be loaded from an untrusted bundle")
+	}
+	if _, err := NewCheckerWithConfig(Config{DisableRemote: true}).LoadBundleFile(path); err == nil {
+		t.Error("expected error without BundlePublicKeys")
+	}
+}
+
+func TestLoadBundleRejects(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := []byte(`{"holidays": {"2030-10-01": "国庆节"}, "workdays": {}, "inLieuDays": {}}`)
+	sum := sha256.Sum256(valid)
+	digest := hex.EncodeToString(sum[:])
+
+	tests := []struct {
+		name     string
+		files    map[string][]byte
+		manifest map[string]string
+		want     string
+	}{
+		{"tampered", map[string][]byte{"2030.json": []byte(`{"holidays": {}}`)}, map[string]string{"2030.json": digest}, "摘要与清单不符"},
+		{"missing", map[string][]byte{}, map[string]string{"2030.json": digest}, "缺少清单列出的 2030.json"},
+		{"unlisted", map[string][]byte{"2030.json": valid, "2031.json": valid}, map[string]string{"2030.json": digest}, "未在清单中列出"},
+		{"bad name", map[string][]byte{"latest.json": valid}, nil, "文件名无效"},
+		{"invalid json", map[string][]byte{"2030.json": valid, "2031.json": []byte("{")}, nil, "解析 JSON 失败"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewCheckerWithConfig(Config{DisableRemote: true, BundlePublicKeys: []ed25519.PublicKey{pub}})
+			_, err := checker.LoadBundle(bytes.NewReader(zipBundle(t, key, tt.files, tt.manifest)))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("LoadBundle error = %v, want containing %q", err, tt.want)
+			}
+			// 任一年份失败时不加载任何数据
+			if checker.IsYearLoaded(2030) {
+				t.Error("no year should be loaded from a rejected bundle")
+			}
+		})
+	}
+
+	checker := NewCheckerWithConfig(Config{DisableRemote: true, BundlePublicKeys: []ed25519.PublicKey{pub}})
+	manifest, err := checker.LoadBundle(bytes.NewReader(zipBundle(t, key, map[string][]byte{"2030.json": valid}, nil)))
+	if err != nil {
+		t.Fatalf("LoadBundle(zip) failed: %v", err)
+	}
+	if !reflect.DeepEqual(manifest.Years(), []int{2030}) || !checker.IsYearLoaded(2030) {
+		t.Errorf("years = %v, loaded = %v", manifest.Years(), checker.IsYearLoaded(2030))
+	}
+
+	if err := (Config{DisableRemote: true, BundlePublicKeys: []ed25519.PublicKey{pub[:16]}}).Validate(); err == nil {
+		t.Error("expected validation error for short public key")
+	}
+}
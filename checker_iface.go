@@ -0,0 +1,75 @@
+package cnholiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// HolidayChecker 节假日查询接口，由 Checker、RemoteChecker 以及 cnholidaytest.Fake 实现
+// 应用代码可以依赖该接口，便于在测试中替换，或叠加缓存、监控等装饰器
+type HolidayChecker interface {
+	IsHoliday(date time.Time) (bool, string, error)
+	IsWorkday(date time.Time) (bool, error)
+	GetHolidayInfo(date time.Time) (*HolidayInfo, error)
+	Classify(date time.Time) (DayType, string, error)
+	CountWorkdaysBetween(start, end time.Time) (int, error)
+	AddWorkdays(date time.Time, n int) (time.Time, error)
+}
+
+// maxWorkdaySteps StepWorkdays 允许的最大工作日天数，约为一万年的天数
+// 超出后不可能有数据覆盖，直接报错而不是逐日查找到数据缺失为止
+const maxWorkdaySteps = 10000 * 366
+
+var (
+	_ HolidayChecker = (*Checker)(nil)
+	_ HolidayChecker = (*RemoteChecker)(nil)
+)
+
+// CountWorkdays 基于单日查询统计 [start, end] 闭区间内的工作日天数，
+// 供自行实现 HolidayChecker 的类型(如装饰器)复用，使区间统计同样经过其单日查询
+func CountWorkdays(isWorkday func(time.Time) (bool, error), start, end time.Time) (int, error) {
+	return countWorkdays(isWorkday, DateRange{Start: start, End: end})
+}
+
+// countWorkdays 基于单日查询统计区间内的工作日天数，按 r.Bounds 处理两端
+func countWorkdays(isWorkday func(time.Time) (bool, error), r DateRange) (int, error) {
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for date := range r.All() {
+		ok, err := isWorkday(date)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// StepWorkdays 基于单日查询返回 date 之后第 n 个工作日(不含当天)，n 为负数时向前查找，
+// 供自行实现 HolidayChecker 的类型复用
+func StepWorkdays(isWorkday func(time.Time) (bool, error), date time.Time, n int) (time.Time, error) {
+	if n > maxWorkdaySteps || n < -maxWorkdaySteps {
+		return time.Time{}, fmt.Errorf("工作日天数 %d 超出范围", n)
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		date = date.AddDate(0, 0, step)
+		ok, err := isWorkday(date)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if ok {
+			n--
+		}
+	}
+	return date, nil
+}
@@ -0,0 +1,39 @@
+package cnholiday
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHolidayChecker(t *testing.T) {
+	var checker HolidayChecker = NewCheckerWithConfig(Config{DisableRemote: true})
+
+	count, err := checker.CountWorkdaysBetween(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil || count != 18 {
+		t.Errorf("CountWorkdaysBetween() = %d, %v; want 18", count, err)
+	}
+
+	if _, err := CountWorkdays(checker.IsWorkday, time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error when end is before start")
+	}
+
+	prev, err := StepWorkdays(checker.IsWorkday, time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC), -1)
+	if err != nil || prev.Format("2006-01-02") != "2026-09-30" {
+		t.Errorf("StepWorkdays(-1) = %s, %v; want 2026-09-30", prev.Format("2006-01-02"), err)
+	}
+}
+
+func TestStepWorkdaysBounds(t *testing.T) {
+	always := func(time.Time) (bool, error) { return true, nil }
+	date := time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC)
+
+	for _, n := range []int{math.MinInt, math.MaxInt, maxWorkdaySteps + 1, -maxWorkdaySteps - 1} {
+		if _, err := StepWorkdays(always, date, n); err == nil {
+			t.Errorf("StepWorkdays(%d) expected error", n)
+		}
+	}
+	if got, err := StepWorkdays(always, date, -maxWorkdaySteps); err != nil || !got.Before(date) {
+		t.Errorf("StepWorkdays(-max) = %v, %v", got, err)
+	}
+}
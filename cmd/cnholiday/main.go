@@ -0,0 +1,282 @@
+// cnholiday 中国节假日命令行工具
+//
+// 用法:
+//
+//	cnholiday cal [year [month]]   输出月历，放假日期标注"休"，调休工作日标注"班"
+//	cnholiday serve [-addr :8080]  启动 HTTP 服务，供 RemoteChecker 等客户端查询
+//	cnholiday dim start [end]      以 CSV 格式输出日期维度表
+//	cnholiday sql [-dialect mysql] [-table cn_calendar] start [end]
+//	                               输出将日历同步到数据库表的幂等 SQL，可直接通过管道交给 mysql、psql 或 clickhouse-client 执行
+//	cnholiday notice [-year 2027] [file]
+//	                               将国务院办公厅节假日安排通知的正文转换为年份数据 JSON，省略 file 时从标准输入读取
+//	cnholiday bundle -key key.pem [-version v] [-o bundle.tar.gz] 2026.json...
+//	                               将年份数据文件打包为签名数据包，供 Checker.LoadBundle 加载；
+//	                               私钥为 PKCS#8 PEM 格式的 Ed25519 私钥，可由 openssl genpkey -algorithm ed25519 生成
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/luojiego/cnholiday"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "cal":
+		err = runCal(os.Args[2:])
+	case "serve":
+		err = runServe(os.Args[2:])
+	case "dim":
+		err = runDim(os.Args[2:])
+	case "sql":
+		err = runSQL(os.Args[2:])
+	case "notice":
+		err = runNotice(os.Args[2:])
+	case "bundle":
+		err = runBundle(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "错误:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "用法: cnholiday cal [year [month]]")
+	fmt.Fprintln(os.Stderr, "      cnholiday serve [-addr :8080]")
+	fmt.Fprintln(os.Stderr, "      cnholiday dim start [end]")
+	fmt.Fprintln(os.Stderr, "      cnholiday sql [-dialect mysql|postgres|clickhouse] [-table cn_calendar] start [end]")
+	fmt.Fprintln(os.Stderr, "      cnholiday notice [-year 2027] [file]")
+	fmt.Fprintln(os.Stderr, "      cnholiday bundle -key key.pem [-version v] [-o bundle.tar.gz] 2026.json...")
+	os.Exit(2)
+}
+
+// runCal 输出指定月份的月历，只指定年份时输出全年
+func runCal(args []string) error {
+	now := time.Now()
+	year, months := now.Year(), []time.Month{now.Month()}
+	if len(args) > 2 {
+		usage()
+	}
+	if len(args) >= 1 {
+		y, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("年份无效: %s", args[0])
+		}
+		year = y
+		months = months[:0]
+		for m := time.January; m <= time.December; m++ {
+			months = append(months, m)
+		}
+	}
+	if len(args) == 2 {
+		m, err := strconv.Atoi(args[1])
+		if err != nil || m < 1 || m > 12 {
+			return fmt.Errorf("月份无效: %s", args[1])
+		}
+		months = []time.Month{time.Month(m)}
+	}
+
+	opts := cnholiday.RenderOptions{Color: isTerminal(os.Stdout)}
+	for i, m := range months {
+		if i > 0 {
+			fmt.Println()
+		}
+		if err := cnholiday.DefaultChecker().RenderMonth(os.Stdout, year, m, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runServe 启动 HTTP 服务
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "监听地址")
+	fs.Parse(args)
+
+	fmt.Fprintln(os.Stderr, "监听", *addr)
+	return http.ListenAndServe(*addr, cnholiday.NewHandler(cnholiday.DefaultChecker()))
+}
+
+// runDim 以 CSV 格式输出 start 至 end 年的日期维度表，省略 end 时只输出 start 年
+func runDim(args []string) error {
+	start, end, err := parseYearRange(args)
+	if err != nil {
+		return err
+	}
+	return cnholiday.DefaultChecker().WriteDateDimensionCSV(os.Stdout, start, end)
+}
+
+// runSQL 输出将 start 至 end 年的日历同步到数据库表的 SQL 语句
+func runSQL(args []string) error {
+	fs := flag.NewFlagSet("sql", flag.ExitOnError)
+	dialect := fs.String("dialect", "mysql", "数据库方言: mysql、postgres 或 clickhouse")
+	table := fs.String("table", "cn_calendar", "目标表名")
+	fs.Parse(args)
+
+	start, end, err := parseYearRange(fs.Args())
+	if err != nil {
+		return err
+	}
+	return cnholiday.DefaultChecker().WriteSQLUpsert(os.Stdout, cnholiday.SQLDialect(*dialect), *table, start, end)
+}
+
+// runNotice 将节假日安排通知转换为年份数据 JSON 输出到标准输出，校验和异常检查的结果输出到标准错误
+func runNotice(args []string) error {
+	fs := flag.NewFlagSet("notice", flag.ExitOnError)
+	year := fs.Int("year", 0, "通知对应的年份，默认从通知标题中识别")
+	fs.Parse(args)
+	if fs.NArg() > 1 {
+		usage()
+	}
+
+	in := io.Reader(os.Stdin)
+	if fs.NArg() == 1 {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+	text, err := io.ReadAll(in)
+	if err != nil {
+		return err
+	}
+
+	if *year == 0 {
+		if *year, err = cnholiday.NoticeYear(string(text)); err != nil {
+			return err
+		}
+	}
+	data, err := cnholiday.ParseNotice(*year, string(text))
+	if err != nil {
+		return err
+	}
+	data.SchemaVersion = cnholiday.CurrentSchemaVersion
+	out, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Printf("%s\n", out); err != nil {
+		return err
+	}
+
+	// 生成的数据需要人工核对，校验和异常检查只提示不影响输出
+	if err := cnholiday.ValidateYear(*year, out); err != nil {
+		fmt.Fprintln(os.Stderr, "警告:", err)
+	}
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{DisableRemote: true})
+	checker.Import(map[int]*cnholiday.HolidayData{*year: data})
+	issues, err := checker.DetectAnomalies(*year)
+	if err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		fmt.Fprintln(os.Stderr, "警告:", issue)
+	}
+	return nil
+}
+
+func runBundle(args []string) error {
+	fs := flag.NewFlagSet("bundle", flag.ExitOnError)
+	keyFile := fs.String("key", "", "PKCS#8 PEM 格式的 Ed25519 签名私钥")
+	version := fs.String("version", "", "数据包版本号，写入清单")
+	output := fs.String("o", "", "输出文件，默认写到标准输出")
+	fs.Parse(args)
+	if *keyFile == "" || fs.NArg() == 0 {
+		usage()
+	}
+
+	pemData, err := os.ReadFile(*keyFile)
+	if err != nil {
+		return err
+	}
+	block, _ := pem.Decode(pemData)
+	if block == nil {
+		return fmt.Errorf("%s 不是 PEM 格式", *keyFile)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("解析私钥失败: %w", err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return fmt.Errorf("%s 不是 Ed25519 私钥", *keyFile)
+	}
+
+	// 文件名为 {year}.json，打包前逐个校验
+	data := make(map[int]*cnholiday.HolidayData, fs.NArg())
+	for _, name := range fs.Args() {
+		year, err := strconv.Atoi(strings.TrimSuffix(filepath.Base(name), ".json"))
+		if err != nil {
+			return fmt.Errorf("%s: 文件名应为 {year}.json", name)
+		}
+		content, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if err := cnholiday.ValidateYear(year, content); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		var yearData cnholiday.HolidayData
+		if err := json.Unmarshal(content, &yearData); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		data[year] = &yearData
+	}
+
+	out := io.Writer(os.Stdout)
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	return cnholiday.WriteBundle(out, key, *version, data)
+}
+
+// parseYearRange 解析 "start [end]" 形式的年份范围，省略 end 时只包含 start 年
+func parseYearRange(args []string) (start, end int, err error) {
+	if len(args) < 1 || len(args) > 2 {
+		usage()
+	}
+	years := make([]int, len(args))
+	for i, arg := range args {
+		if years[i], err = strconv.Atoi(arg); err != nil {
+			return 0, 0, fmt.Errorf("年份无效: %s", arg)
+		}
+	}
+	return years[0], years[len(years)-1], nil
+}
+
+// isTerminal 判断输出是否是终端，重定向到文件或管道时不输出颜色
+func isTerminal(f *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
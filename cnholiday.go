@@ -1,6 +1,10 @@
 package cnholiday
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
 	"embed"
 	"encoding/json"
 	"errors"
@@ -10,7 +14,11 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -19,11 +27,30 @@ var embeddedData embed.FS
 
 // HolidayData 节假日数据结构
 type HolidayData struct {
-	Holidays   map[string]string `json:"holidays"`   // 法定节假日
-	Workdays   map[string]string `json:"workdays"`   // 调休工作日
-	InLieuDays map[string]string `json:"inLieuDays"` // 补休日
+	SchemaVersion int               `json:"schemaVersion,omitempty"` // 数据格式版本，缺省时视为 1，参见 CurrentSchemaVersion
+	Holidays      map[string]string `json:"holidays"`                // 法定节假日
+	Workdays      map[string]string `json:"workdays"`                // 调休工作日
+	InLieuDays    map[string]string `json:"inLieuDays"`              // 补休日
 }
 
+// Source 节假日数据来源
+type Source string
+
+const (
+	SourceRemote       Source = "remote"        // 远程 CDN
+	SourceLocal        Source = "local"         // 本地数据目录
+	SourceEmbedded     Source = "embedded"      // 库内置的嵌入数据
+	SourceConfigCenter Source = "config_center" // 配置中心，见 ConfigCenter
+	SourceJSON         Source = "json"          // 通过 LoadYearFromJSON 直接提供的数据
+	SourcePatch        Source = "patch"         // 通过 PatchYear 合并的修订
+	SourceImport       Source = "import"        // 通过 Import 从其他检查器导入的数据
+	SourceNotice       Source = "notice"        // StartPolling 从官方通知页面解析的数据，参见 ParseNotice
+	SourceBundle       Source = "bundle"        // 通过 LoadBundle 加载的签名数据包
+
+	SourceOverride  Source = "override"  // 租户自定义的覆盖规则，仅出现在查询结果中
+	SourceHeuristic Source = "heuristic" // 缺少数据时按周末推断，仅出现在查询结果中
+)
+
 // Config 配置选项
 type Config struct {
 	// LocalDataDir 本地数据文件目录路径
@@ -33,204 +60,550 @@ type Config struct {
 	DisableRemote bool
 	// CDNBaseURL 自定义 CDN 基础 URL
 	CDNBaseURL string
+	// RemoteURLTemplate 远程数据地址模板，{year} 会被替换为年份，例如 "https://internal/holidays/{year}/data.json"
+	// 设置后优先于 CDNBaseURL，用于目录结构与 {base}/{year}.json 不同的内部镜像
+	RemoteURLTemplate string
+	// RemoteTimeout 远程 CDN 请求超时时间，默认 10 秒
+	RemoteTimeout time.Duration
+	// LocalTimeout 本地文件读取超时时间，0 表示不限制
+	LocalTimeout time.Duration
+	// BreakerThreshold 远程连续失败多少次后熔断，0 表示不启用熔断
+	BreakerThreshold int
+	// BreakerCooldown 熔断后跳过远程数据源的冷却时间，默认 30 秒
+	BreakerCooldown time.Duration
+	// LoadConcurrency LoadYears 并发加载的最大年份数，默认 4
+	LoadConcurrency int
+	// StrictValidation 加载时校验数据格式，并拒绝日期不属于对应年份的数据
+	StrictValidation bool
+	// RemoteRateLimit 全局远程请求速率上限(次/秒)，0 表示不限流
+	RemoteRateLimit float64
+	// RemoteHostRateLimit 单个主机的远程请求速率上限(次/秒)，0 表示不限流
+	RemoteHostRateLimit float64
+	// RemoteRateBurst 限流允许的突发请求数，默认 1
+	RemoteRateBurst int
+	// ProxyURL 远程请求使用的代理地址，为空时使用 HTTP(S)_PROXY 环境变量
+	ProxyURL string
+	// RootCAs 远程请求信任的根证书，为空时使用系统证书
+	RootCAs *x509.CertPool
+	// TLSConfig 远程请求的自定义 TLS 配置，RootCAs 不为空时会覆盖其中的 RootCAs
+	TLSConfig *tls.Config
+	// RemoteAuth 远程数据源的认证方式，用于需要认证的内部服务或对象存储，为 nil 时不认证
+	RemoteAuth *RemoteAuth
+	// ConfigCenter 配置中心数据源，设置后默认最先尝试
+	ConfigCenter ConfigCenter
+	// ConfigCenterKey 年份数据在配置中心中的键模板，{year} 会被替换为年份，默认为 "cnholiday/{year}.json"
+	ConfigCenterKey string
+	// FiscalYearStart 财年起始月份，默认 1 月(与自然年一致)
+	FiscalYearStart time.Month
+	// HeuristicFallback 数据加载失败时仍然作答，仅按周末判断，并将结果标记为推断
+	HeuristicFallback bool
+	// OnHeuristic 按周末推断作答时调用的告警回调，err 为数据加载失败的原因
+	OnHeuristic func(date time.Time, err error)
+	// OnAnomaly 加载的年份数据与历年规律不符时调用的告警回调，数据仍会写入缓存，参见 DetectAnomalies
+	OnAnomaly func(anomaly *Anomaly)
+	// MinYear、MaxYear 允许自动加载的年份范围，默认 2004 ~ 2100
+	// 超出范围的年份不会请求任何数据源，直接返回 *YearRangeError；LoadYearFromJSON 等显式提供的数据不受限制
+	MinYear int
+	MaxYear int
+	// SourceOrder 数据源的尝试顺序，可选 SourceConfigCenter、SourceRemote、SourceLocal、SourceEmbedded，
+	// 默认为配置中心、远程、本地、嵌入数据。未列出的数据源不会被使用；
+	// 未设置 ConfigCenter、DisableRemote 为 true 或未设置 LocalDataDir 时对应的数据源同样会被跳过
+	SourceOrder []Source
+	// BundlePublicKeys LoadBundle 信任的 Ed25519 公钥，数据包的清单须由其中任一公钥对应的私钥签名
+	BundlePublicKeys []ed25519.PublicKey
+	// PollURLs StartPolling 检查的官方通知页面或镜像地址，{year} 会被替换为年份，按顺序尝试
+	// 响应为 JSON 时按年份数据文件解析，否则视为通知正文(可以是 HTML 页面)，通过 ParseNotice 解析
+	PollURLs []string
+	// PollInterval StartPolling 的检查间隔，默认 6 小时
+	PollInterval time.Duration
+	// OnPollError StartPolling 检查失败时调用的回调，通知尚未发布(HTTP 404 或页面中没有该年份的通知)不视为失败
+	OnPollError func(year int, url string, err error)
+	// WorkdayJumpTable 为已加载的连续年份预先计算工作日跳转表，NextWorkday、PrevWorkday、AddWorkdays
+	// 在表覆盖的范围内直接通过数组下标得到结果，适合结算批处理等每秒调用上百万次的场景。
+	// 表在数据变更后的第一次查询时重建，每个年份约占 3KB；超出已加载年份的查询按原方式逐年加载
+	WorkdayJumpTable bool
+	// AsyncRefresh 先用本地或嵌入数据作答，同时在后台从远程刷新，首次查询无需等待远程请求
+	// 远程数据加载成功后替换缓存并通知订阅者；本地和嵌入数据都没有该年份时仍同步请求远程
+	AsyncRefresh bool
+}
+
+const (
+	defaultCDNBaseURL      = "https://cdn.jsdelivr.net/npm/chinese-days/dist/years"
+	defaultRemoteTimeout   = 10 * time.Second
+	defaultBreakerCooldown = 30 * time.Second
+	defaultLoadConcurrency = 4
+	defaultPollInterval    = 6 * time.Hour
+
+	// yearPlaceholder RemoteURLTemplate、ConfigCenterKey、PollURLs 中的年份占位符
+	yearPlaceholder = "{year}"
+
+	// DefaultMinYear 默认允许加载的最早年份，即上游数据集的起始年份
+	DefaultMinYear = 2004
+	// DefaultMaxYear 默认允许加载的最晚年份
+	DefaultMaxYear = 2100
+)
+
+// applyDefaults 为未设置的配置项填充默认值
+func (config *Config) applyDefaults() {
+	if config.CDNBaseURL == "" {
+		config.CDNBaseURL = defaultCDNBaseURL
+	}
+	if config.RemoteTimeout <= 0 {
+		config.RemoteTimeout = defaultRemoteTimeout
+	}
+	if config.BreakerThreshold > 0 && config.BreakerCooldown <= 0 {
+		config.BreakerCooldown = defaultBreakerCooldown
+	}
+	if config.LoadConcurrency <= 0 {
+		config.LoadConcurrency = defaultLoadConcurrency
+	}
+	if config.FiscalYearStart < time.January || config.FiscalYearStart > time.December {
+		config.FiscalYearStart = time.January
+	}
+	if config.MinYear == 0 {
+		config.MinYear = DefaultMinYear
+	}
+	if config.MaxYear == 0 {
+		config.MaxYear = DefaultMaxYear
+	}
+	if config.ConfigCenterKey == "" {
+		config.ConfigCenterKey = defaultConfigCenterKey
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	config.PollURLs = slices.Clone(config.PollURLs)
+	config.BundlePublicKeys = slices.Clone(config.BundlePublicKeys)
+	config.SourceOrder = slices.Clone(config.SourceOrder) // 避免调用方之后修改
 }
 
 // Checker 节假日检查器
+// Checker 的所有方法（包括 Set* 配置方法）都可以在多个 goroutine 中并发调用。
+// 配置和年份索引采用写时复制，查询读取的总是某个完整的快照
 type Checker struct {
-	mu     sync.RWMutex
-	cache  map[int]*HolidayData // 按年份缓存
-	config Config
+	mu         sync.RWMutex
+	cache      map[int]*yearIndex     // 按年份缓存
+	cacheGen   atomic.Uint64          // cache 的版本号，每次修改 cache 时在 mu 的保护下加一
+	reports    map[int]*LoadReport    // 按年份记录的加载报告
+	history    map[int][]Amendment    // 按年份记录的变更历史，只追加
+	config     atomic.Pointer[Config] // 配置快照，写时复制，Set* 方法整体替换
+	httpClient *http.Client
+	clientErr  error           // HTTP 客户端配置错误
+	breaker    *circuitBreaker // 远程数据源熔断器
+	limiter    *rateLimiter    // 远程请求限流器
+	counters   counters        // 统计计数
+	remoteOnly bool            // 仅使用远程数据源，用于 RemoteChecker
+	refreshing map[int]bool    // 正在后台刷新的年份，避免同一年份重复发起请求
+	loadMu     sync.Mutex
+	loading    map[int]*yearLoad // 进行中的按需加载，同一年份的并发查询共享一次加载，由 loadMu 保护
+	background sync.WaitGroup    // 后台刷新等后台任务
+	lifetime   context.Context   // Close 后取消，用于停止后台任务
+	stop       context.CancelFunc
+	closed     bool // 是否已调用 Close，由 mu 保护
+
+	// crossYear 各年份数据中属于相邻年份的调休工作日，按 目标年份 -> 数据所在年份 记录
+	// 与变更历史一样，ClearYear 和 ClearCache 不会清除
+	crossYear map[int]map[int]map[string]string
+
+	tableMu sync.Mutex
+	table   atomic.Pointer[workdayTable] // 工作日跳转表，参见 Config.WorkdayJumpTable，重建时持有 tableMu
+
+	layerMu sync.RWMutex
+	layers  map[string]*Layer // 命名的日历层
+
+	subMu       sync.Mutex
+	subscribers map[int]func(ChangeEvent) // 数据变更订阅者
+	nextSubID   int
 }
 
 // NewChecker 创建新的检查器
 func NewChecker() *Checker {
-	return &Checker{
-		cache: make(map[int]*HolidayData),
-		config: Config{
-			CDNBaseURL: "https://cdn.jsdelivr.net/npm/chinese-days/dist/years",
-		},
-	}
+	return NewCheckerWithConfig(Config{})
 }
 
 // NewCheckerWithConfig 使用自定义配置创建检查器
 func NewCheckerWithConfig(config Config) *Checker {
-	if config.CDNBaseURL == "" {
-		config.CDNBaseURL = "https://cdn.jsdelivr.net/npm/chinese-days/dist/years"
-	}
-	return &Checker{
-		cache:  make(map[int]*HolidayData),
-		config: config,
+	config.applyDefaults()
+	httpClient, clientErr := newHTTPClient(config)
+	lifetime, stop := context.WithCancel(context.Background())
+	c := &Checker{
+		lifetime:   lifetime,
+		stop:       stop,
+		cache:      make(map[int]*yearIndex),
+		reports:    make(map[int]*LoadReport),
+		history:    make(map[int][]Amendment),
+		crossYear:  make(map[int]map[int]map[string]string),
+		httpClient: httpClient,
+		clientErr:  clientErr,
+		breaker:    newCircuitBreaker(config.BreakerThreshold, config.BreakerCooldown),
+		limiter:    newRateLimiter(config.RemoteRateLimit, config.RemoteHostRateLimit, config.RemoteRateBurst),
 	}
+	c.config.Store(&config)
+	return c
 }
 
 // LoadYear 加载指定年份的节假日数据
-// 加载优先级：
-// 1. 远程 CDN（如果未禁用）
-// 2. 用户配置的本地目录（如果配置了 LocalDataDir）
-// 3. 库内置的嵌入数据（如果网络和本地都失败，自动使用）
+// 默认加载优先级如下，可以通过 Config.SourceOrder 调整：
+// 1. 配置中心（如果配置了 ConfigCenter）
+// 2. 远程 CDN（如果未禁用）
+// 3. 用户配置的本地目录（如果配置了 LocalDataDir）
+// 4. 库内置的嵌入数据（如果网络和本地都失败，自动使用）
+// 全部失败时返回 *YearLoadError，记录每个数据源的失败原因
 func (c *Checker) LoadYear(year int) error {
-	var lastErr error
-
-	// 1. 尝试从远程 CDN 获取（如果未禁用）
-	if !c.config.DisableRemote {
-		if err := c.loadYearFromRemote(year); err == nil {
-			return nil // 成功从远程加载
-		} else {
-			lastErr = fmt.Errorf("远程加载失败: %w", err)
-		}
+	return c.LoadYearContext(context.Background(), year)
+}
+
+// LoadYearContext 与 LoadYear 相同，ctx 取消时停止尝试后续数据源
+// 每次加载的结果都会记录到 LoadReport 中，因 ctx 取消而中止的加载除外，不影响已有的报告和统计。未通过解析或校验的数据会被隔离：年份尚未缓存时继续尝试后续数据源，
+// 已有缓存时保留缓存中的数据并返回错误
+func (c *Checker) LoadYearContext(ctx context.Context, year int) error {
+	_, err := c.loadYear(ctx, year)
+	return err
+}
+
+// loadYear 按数据源顺序加载年份数据，返回写入缓存的索引
+func (c *Checker) loadYear(ctx context.Context, year int) (*yearIndex, error) {
+	if year < c.settings().MinYear || year > c.settings().MaxYear {
+		return nil, &YearRangeError{Year: year, Min: c.settings().MinYear, Max: c.settings().MaxYear}
 	}
 
-	// 2. 尝试从本地文件加载（如果配置了本地目录）
-	if c.config.LocalDataDir != "" {
-		if err := c.loadYearFromLocal(year); err == nil {
-			return nil // 成功从本地加载
-		} else {
-			if lastErr != nil {
-				lastErr = fmt.Errorf("%v; 本地加载失败: %w", lastErr, err)
-			} else {
-				lastErr = fmt.Errorf("本地加载失败: %w", err)
+	loadErr := &YearLoadError{Year: year}
+	attempts := make([]LoadAttempt, 0, 3)
+
+	sources, refresh := c.asyncSources(c.sources())
+	for _, source := range sources {
+		start := time.Now()
+		err := ctx.Err()
+		if err != nil {
+			loadErr.Errors = append(loadErr.Errors, &SourceError{Source: source, Err: err})
+			attempts = append(attempts, LoadAttempt{Source: source, Start: start, Err: err})
+			break
+		}
+
+		data, err := c.loadYearFrom(ctx, source, year)
+		attempts = append(attempts, LoadAttempt{
+			Source:   source,
+			Start:    start,
+			Duration: time.Since(start),
+			Err:      err,
+		})
+		if err == nil {
+			amendment := c.store(year, data, source, attempts)
+			if refresh && source != SourceRemote {
+				c.refreshAsync(year)
 			}
+			return amendment.index, nil
+		}
+		loadErr.Errors = append(loadErr.Errors, &SourceError{Source: source, Err: err})
+
+		// 已有缓存时，被隔离的数据不回退到优先级更低(通常也更旧)的数据源，继续使用缓存中的数据
+		var qerr *QuarantineError
+		if errors.As(err, &qerr) && c.IsYearLoaded(year) {
+			break
 		}
 	}
 
-	// 3. 尝试从嵌入的文件系统加载（库内置数据）
-	if err := c.loadYearFromEmbedded(year); err == nil {
-		return nil // 成功从嵌入数据加载
-	} else {
-		if lastErr != nil {
-			lastErr = fmt.Errorf("%v; 嵌入数据加载失败: %w", lastErr, err)
-		} else {
-			lastErr = fmt.Errorf("嵌入数据加载失败: %w", err)
+	// 调用方取消不是数据源的问题，不记录为加载失败
+	if ctx.Err() == nil {
+		c.recordFailure(year, attempts, loadErr)
+	}
+	return nil, loadErr
+}
+
+// defaultSourceOrder 未配置 SourceOrder 时的数据源顺序
+var defaultSourceOrder = []Source{SourceConfigCenter, SourceRemote, SourceLocal, SourceEmbedded}
+
+// sources 返回按优先级排列的已启用数据源
+func (c *Checker) sources() []Source {
+	if c.remoteOnly {
+		return []Source{SourceRemote}
+	}
+	order := c.settings().SourceOrder
+	if len(order) == 0 {
+		order = defaultSourceOrder
+	}
+
+	sources := make([]Source, 0, len(order))
+	for _, source := range order {
+		switch {
+		case slices.Contains(sources, source):
+		case source == SourceRemote && c.settings().DisableRemote:
+		case source == SourceLocal && c.settings().LocalDataDir == "":
+		case source == SourceConfigCenter && c.settings().ConfigCenter == nil:
+		case knownSource(source):
+			sources = append(sources, source)
 		}
 	}
+	return sources
+}
 
-	// 4. 所有方式都失败
-	if lastErr != nil {
-		return fmt.Errorf("无法加载 %d 年的节假日数据: %w", year, lastErr)
+// knownSource 是否是可以在 SourceOrder 中使用的数据源
+func knownSource(source Source) bool {
+	switch source {
+	case SourceConfigCenter, SourceRemote, SourceLocal, SourceEmbedded:
+		return true
 	}
+	return false
+}
 
-	return fmt.Errorf("无法加载 %d 年的节假日数据: 未配置数据源", year)
+// loadYearFrom 从指定数据源读取年份数据
+func (c *Checker) loadYearFrom(ctx context.Context, source Source, year int) (*HolidayData, error) {
+	switch source {
+	case SourceRemote:
+		return c.loadYearFromRemote(ctx, year)
+	case SourceLocal:
+		return c.loadYearFromLocal(ctx, year)
+	case SourceEmbedded:
+		return c.loadYearFromEmbedded(year)
+	case SourceConfigCenter:
+		return c.loadYearFromConfigCenter(ctx, year)
+	}
+	return nil, fmt.Errorf("未知数据源: %s", source)
 }
 
 // loadYearFromRemote 从远程 CDN 加载数据
-func (c *Checker) loadYearFromRemote(year int) error {
-	url := fmt.Sprintf("%s/%d.json", c.config.CDNBaseURL, year)
+// 网络错误或 5xx 连续达到阈值后熔断，请求频率超过限流速率时直接跳过
+func (c *Checker) loadYearFromRemote(ctx context.Context, year int) (*HolidayData, error) {
+	url := c.remoteURL(year)
 
-	resp, err := http.Get(url)
+	if !c.breaker.allow() {
+		return nil, ErrCircuitOpen
+	}
+	if !c.limiter.allow(url) {
+		c.breaker.release()
+		return nil, ErrRateLimited
+	}
+
+	body, err := c.fetchRemote(ctx, url)
+	switch {
+	case err == nil:
+		c.breaker.success()
+	case ctx.Err() != nil:
+		// 调用方主动取消不计入熔断统计
+		c.breaker.release()
+		return nil, err
+	case countsAsFailure(err):
+		c.breaker.failure()
+		return nil, err
+	default:
+		// 数据源有响应，只是没有该年份的数据
+		c.breaker.success()
+		return nil, err
+	}
+
+	return c.parseYear(SourceRemote, year, body)
+}
+
+// probeYearFrom 与 loadYearFrom 相同，但远程请求绕过熔断器和限流器，探测结果不影响正常查询
+func (c *Checker) probeYearFrom(ctx context.Context, source Source, year int) (*HolidayData, error) {
+	if source != SourceRemote {
+		return c.loadYearFrom(ctx, source, year)
+	}
+	body, err := c.fetchRemote(ctx, c.remoteURL(year))
 	if err != nil {
-		return fmt.Errorf("网络请求失败: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
+	return c.parseYear(SourceRemote, year, body)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("HTTP 状态码 %d", resp.StatusCode)
+// parseYear 解析年份数据，开启 StrictValidation 时先校验数据
+// 解析或校验失败时返回 *QuarantineError，格式版本过高时其 Err 为 *SchemaVersionError
+func (c *Checker) parseYear(source Source, year int, data []byte) (*HolidayData, error) {
+	if _, err := schemaVersion(data); err != nil {
+		return nil, &QuarantineError{Year: year, Source: source, Data: data, Err: err}
+	}
+	if c.settings().StrictValidation {
+		if err := ValidateYear(year, data); err != nil {
+			return nil, &QuarantineError{Year: year, Source: source, Data: data, Err: err}
+		}
 	}
+	// 格式版本已经检查过，直接解码
+	holidayData, err := decodeHolidayData(data)
+	if err != nil {
+		return nil, &QuarantineError{Year: year, Source: source, Data: data, Err: err}
+	}
+	return holidayData, nil
+}
 
-	body, err := io.ReadAll(resp.Body)
+// parseHolidayData 解析年份数据 JSON，先检查格式版本，避免用旧的结构解析新格式的数据
+func parseHolidayData(data []byte) (*HolidayData, error) {
+	if _, err := schemaVersion(data); err != nil {
+		return nil, err
+	}
+	return decodeHolidayData(data)
+}
+
+// decodeHolidayData 解码年份数据 JSON，不检查格式版本，调用方需先调用 schemaVersion
+func decodeHolidayData(data []byte) (*HolidayData, error) {
+	var holidayData HolidayData
+	if err := json.Unmarshal(data, &holidayData); err != nil {
+		return nil, fmt.Errorf("解析 JSON 失败: %w", err)
+	}
+	return &holidayData, nil
+}
+
+// remoteURL 返回指定年份数据的远程地址
+func (c *Checker) remoteURL(year int) string {
+	if c.settings().RemoteURLTemplate != "" {
+		return strings.ReplaceAll(c.settings().RemoteURLTemplate, yearPlaceholder, strconv.Itoa(year))
+	}
+	return fmt.Sprintf("%s/%d.json", c.settings().CDNBaseURL, year)
+}
+
+// fetchRemote 请求远程地址并返回响应内容
+func (c *Checker) fetchRemote(ctx context.Context, url string) ([]byte, error) {
+	return c.fetch(ctx, url, c.settings().RemoteAuth)
+}
+
+// fetch 使用检查器的 HTTP 客户端请求地址并返回响应内容，auth 为 nil 时不认证
+func (c *Checker) fetch(ctx context.Context, url string, auth *RemoteAuth) ([]byte, error) {
+	if c.clientErr != nil {
+		return nil, fmt.Errorf("HTTP 客户端配置错误: %w", c.clientErr)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	// 显式声明 Accept-Encoding 后需自行解压，同时兼容只提供 gzip 制品的镜像
+	req.Header.Set("Accept-Encoding", "gzip")
+	if err := auth.apply(req); err != nil {
+		return nil, fmt.Errorf("请求认证失败: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("读取响应失败: %w", err)
+		return nil, fmt.Errorf("网络请求失败: %w", err)
 	}
+	defer resp.Body.Close()
 
-	var data HolidayData
-	if err := json.Unmarshal(body, &data); err != nil {
-		return fmt.Errorf("解析 JSON 失败: %w", err)
+	if resp.StatusCode != http.StatusOK {
+		return nil, &HTTPStatusError{StatusCode: resp.StatusCode}
 	}
 
-	c.mu.Lock()
-	c.cache[year] = &data
-	c.mu.Unlock()
+	switch encoding := resp.Header.Get("Content-Encoding"); encoding {
+	case "", "identity", "gzip":
+	default:
+		return nil, fmt.Errorf("不支持的内容编码: %s", encoding)
+	}
 
-	return nil
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("读取响应失败: %w", err)
+	}
+	return maybeGunzip(body)
 }
 
 // loadYearFromLocal 从本地文件加载数据
-func (c *Checker) loadYearFromLocal(year int) error {
-	filename := filepath.Join(c.config.LocalDataDir, fmt.Sprintf("%d.json", year))
+// 优先读取 {year}.json，不存在时尝试 gzip 压缩的 {year}.json.gz
+func (c *Checker) loadYearFromLocal(ctx context.Context, year int) (*HolidayData, error) {
+	filename := filepath.Join(c.settings().LocalDataDir, fmt.Sprintf("%d.json", year))
 
-	data, err := os.ReadFile(filename)
+	data, err := readFileWithTimeout(ctx, filename, c.settings().LocalTimeout)
+	if errors.Is(err, os.ErrNotExist) {
+		data, err = readFileWithTimeout(ctx, filename+".gz", c.settings().LocalTimeout)
+	}
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			return fmt.Errorf("文件不存在: %s", filename)
+			return nil, fmt.Errorf("文件不存在: %s: %w", filename, os.ErrNotExist)
 		}
-		return fmt.Errorf("读取文件失败: %w", err)
+		return nil, fmt.Errorf("读取文件失败: %w", err)
 	}
 
-	var holidayData HolidayData
-	if err := json.Unmarshal(data, &holidayData); err != nil {
-		return fmt.Errorf("解析 JSON 失败: %w", err)
+	data, err = maybeGunzip(data)
+	if err != nil {
+		return nil, err
 	}
 
-	c.mu.Lock()
-	c.cache[year] = &holidayData
-	c.mu.Unlock()
+	return c.parseYear(SourceLocal, year, data)
+}
 
-	return nil
+// readFileWithTimeout 读取文件，超过 timeout 或 ctx 取消时返回错误
+// timeout <= 0 时不限制读取时间
+func readFileWithTimeout(ctx context.Context, name string, timeout time.Duration) ([]byte, error) {
+	if timeout <= 0 && ctx.Done() == nil {
+		return os.ReadFile(name)
+	}
+
+	type result struct {
+		data []byte
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		data, err := os.ReadFile(name)
+		ch <- result{data, err}
+	}()
+
+	var timeoutC <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutC = timer.C
+	}
+	select {
+	case r := <-ch:
+		return r.data, r.err
+	case <-timeoutC:
+		return nil, fmt.Errorf("读取文件超时(%s): %s", timeout, name)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 }
 
 // loadYearFromEmbedded 从嵌入的文件系统加载数据
-func (c *Checker) loadYearFromEmbedded(year int) error {
+func (c *Checker) loadYearFromEmbedded(year int) (*HolidayData, error) {
 	filename := fmt.Sprintf("data/%d.json", year)
 
 	data, err := embeddedData.ReadFile(filename)
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
-			return fmt.Errorf("嵌入文件中不存在: %s", filename)
+			return nil, fmt.Errorf("嵌入文件中不存在: %s: %w", filename, fs.ErrNotExist)
 		}
-		return fmt.Errorf("读取嵌入文件失败: %w", err)
-	}
-
-	var holidayData HolidayData
-	if err := json.Unmarshal(data, &holidayData); err != nil {
-		return fmt.Errorf("解析 JSON 失败: %w", err)
+		return nil, fmt.Errorf("读取嵌入文件失败: %w", err)
 	}
 
-	c.mu.Lock()
-	c.cache[year] = &holidayData
-	c.mu.Unlock()
-
-	return nil
+	return c.parseYear(SourceEmbedded, year, data)
 }
 
 // LoadYearFromJSON 从JSON字节数据加载节假日数据
+// 开启 StrictValidation 时会拒绝格式错误或日期不属于 year 的数据。
+// 被拒绝的数据不会写入缓存，返回 *QuarantineError 并记录到 LoadReport 中
 func (c *Checker) LoadYearFromJSON(year int, jsonData []byte) error {
-	var data HolidayData
-	if err := json.Unmarshal(jsonData, &data); err != nil {
-		return fmt.Errorf("failed to parse holiday data: %w", err)
+	start := time.Now()
+	data, err := c.parseYear(SourceJSON, year, jsonData)
+	attempts := []LoadAttempt{{Source: SourceJSON, Start: start, Duration: time.Since(start), Err: err}}
+	if err != nil {
+		c.recordFailure(year, attempts, err)
+		return err
 	}
 
-	c.mu.Lock()
-	c.cache[year] = &data
-	c.mu.Unlock()
-
-	return nil
-}
-
-// ensureYearLoaded 确保年份数据已加载
-func (c *Checker) ensureYearLoaded(year int) error {
-	c.mu.RLock()
-	_, exists := c.cache[year]
-	c.mu.RUnlock()
-
-	if !exists {
-		if err := c.LoadYear(year); err != nil {
-			return fmt.Errorf("加载 %d 年数据失败: %w", year, err)
-		}
-	}
+	c.store(year, data, SourceJSON, attempts)
 	return nil
 }
 
 // SetLocalDataDir 设置本地数据目录
 func (c *Checker) SetLocalDataDir(dir string) {
-	c.mu.Lock()
-	c.config.LocalDataDir = dir
-	c.mu.Unlock()
+	c.updateConfig(func(cfg *Config) { cfg.LocalDataDir = dir })
 }
 
 // SetDisableRemote 设置是否禁用远程获取
 func (c *Checker) SetDisableRemote(disable bool) {
+	c.updateConfig(func(cfg *Config) { cfg.DisableRemote = disable })
+}
+
+// settings 返回当前配置快照，调用方不得修改
+func (c *Checker) settings() *Config {
+	return c.config.Load()
+}
+
+// updateConfig 复制当前配置，修改后整体替换，读取方不会看到半更新状态
+func (c *Checker) updateConfig(fn func(*Config)) {
 	c.mu.Lock()
-	c.config.DisableRemote = disable
-	c.mu.Unlock()
+	defer c.mu.Unlock()
+	next := *c.config.Load()
+	fn(&next)
+	c.config.Store(&next)
 }
 
 // IsYearLoaded 检查指定年份的数据是否已加载
@@ -241,10 +614,29 @@ func (c *Checker) IsYearLoaded(year int) bool {
 	return exists
 }
 
+// Close 停止检查器的全部后台任务：取消进行中的后台刷新和 StartPolling 的轮询并等待其退出，停止 WatchConfigCenter 的监听，
+// 并移除全部订阅者(包括 EventPublisher)。可以多次调用，也可以与查询并发调用；
+// Close 之后查询仍然可用，缓存中的数据继续有效，未缓存的年份会同步加载
+func (c *Checker) Close() error {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+
+	c.stop()
+	c.background.Wait()
+
+	c.subMu.Lock()
+	clear(c.subscribers)
+	c.subMu.Unlock()
+	return nil
+}
+
 // ClearCache 清空缓存
 func (c *Checker) ClearCache() {
 	c.mu.Lock()
-	c.cache = make(map[int]*HolidayData)
+	c.cache = make(map[int]*yearIndex)
+	c.cacheGen.Add(1)
+	c.reports = make(map[int]*LoadReport)
 	c.mu.Unlock()
 }
 
@@ -252,40 +644,27 @@ func (c *Checker) ClearCache() {
 func (c *Checker) ClearYear(year int) {
 	c.mu.Lock()
 	delete(c.cache, year)
+	c.cacheGen.Add(1)
+	delete(c.reports, year)
 	c.mu.Unlock()
 }
 
 // IsHoliday 判断指定日期是否是节假日(休息日)
 // 返回: isHoliday, holidayName, error
 func (c *Checker) IsHoliday(date time.Time) (bool, string, error) {
-	year := date.Year()
-	if err := c.ensureYearLoaded(year); err != nil {
+	dayType, name, _, err := c.classify(date)
+	if err != nil {
 		return false, "", err
 	}
 
-	dateStr := date.Format("2006-01-02")
-
-	c.mu.RLock()
-	data := c.cache[year]
-	c.mu.RUnlock()
-
-	// 1. 检查是否在调休工作日列表中(周末变工作日)
-	if name, exists := data.Workdays[dateStr]; exists {
+	switch dayType {
+	case DayTypeAdjustedWorkday:
 		return false, name, nil // 是调休工作日,不是假日
-	}
-
-	// 2. 检查是否在法定节假日列表中
-	if name, exists := data.Holidays[dateStr]; exists {
+	case DayTypePublicHoliday, DayTypeInLieu:
 		return true, name, nil // 是法定节假日
-	}
-
-	// 3. 检查是否是周末
-	weekday := date.Weekday()
-	if weekday == time.Saturday || weekday == time.Sunday {
+	case DayTypeWeekend:
 		return true, "周末", nil
 	}
-
-	// 4. 工作日
 	return false, "", nil
 }
 
@@ -300,65 +679,109 @@ func (c *Checker) IsWorkday(date time.Time) (bool, error) {
 
 // GetHolidayInfo 获取节假日详细信息
 func (c *Checker) GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
-	year := date.Year()
-	if err := c.ensureYearLoaded(year); err != nil {
+	info := &HolidayInfo{}
+	if err := c.GetHolidayInfoInto(date, info); err != nil {
 		return nil, err
 	}
+	return info, nil
+}
 
-	dateStr := date.Format("2006-01-02")
-	weekday := date.Weekday()
-
-	c.mu.RLock()
-	data := c.cache[year]
-	c.mu.RUnlock()
+// GetHolidayInfoInto 与 GetHolidayInfo 相同，但将结果写入调用方提供的 info，
+// 年份已缓存时不产生内存分配，适合高吞吐场景复用同一个 HolidayInfo
+func (c *Checker) GetHolidayInfoInto(date time.Time, info *HolidayInfo) error {
+	return c.getHolidayInfoContext(context.Background(), date, info)
+}
 
-	info := &HolidayInfo{
-		Date:    date,
-		Weekday: weekday,
+// getHolidayInfoContext 与 GetHolidayInfoInto 相同，缺少数据的年份在 ctx 的控制下加载
+func (c *Checker) getHolidayInfoContext(ctx context.Context, date time.Time, info *HolidayInfo) error {
+	dayType, name, idx, err := c.classifyContext(ctx, date)
+	if err != nil {
+		return err
 	}
 
-	// 检查调休工作日
-	if name, exists := data.Workdays[dateStr]; exists {
-		info.IsWorkday = true
-		info.IsAdjustedWorkday = true
-		info.HolidayName = name
-		return info, nil
+	fillHolidayInfo(info, date, dayType, name)
+	if idx == nil {
+		info.Heuristic = true
+		info.Source = SourceHeuristic
+	} else {
+		info.Source = idx.source
+		info.DataVersion = idx.version
 	}
+	return nil
+}
 
-	// 检查法定节假日
-	if name, exists := data.Holidays[dateStr]; exists {
-		info.IsHoliday = true
-		info.HolidayName = name
+// classify 查询日期类型，是所有单日查询的公共入口，同时返回作答所用的年份索引
+// 数据加载失败且开启 HeuristicFallback 时按周末推断，返回的索引为 nil
+func (c *Checker) classify(date time.Time) (DayType, string, *yearIndex, error) {
+	return c.classifyContext(context.Background(), date)
+}
 
-		// 检查是否是补休日
-		if _, isInLieu := data.InLieuDays[dateStr]; isInLieu {
-			info.IsInLieuDay = true
+// classifyContext 与 classify 相同，缺少数据的年份在 ctx 的控制下加载；
+// ctx 取消或超时时即使开启 HeuristicFallback 也返回错误
+func (c *Checker) classifyContext(ctx context.Context, date time.Time) (DayType, string, *yearIndex, error) {
+	idx, err := c.indexContext(ctx, date.Year())
+	if err != nil {
+		if !c.settings().HeuristicFallback || ctx.Err() != nil {
+			return DayTypeWorkday, "", nil, err
 		}
-		return info, nil
+		c.counters.heuristic.Add(1)
+		if c.settings().OnHeuristic != nil {
+			c.settings().OnHeuristic(date, err)
+		}
+		dayType := DayTypeWorkday
+		if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			dayType = DayTypeWeekend
+		}
+		return dayType, "", nil, nil
 	}
 
-	// 检查周末
-	if weekday == time.Saturday || weekday == time.Sunday {
+	dayType, name := idx.lookup(date)
+	c.countQuery(dayType)
+	return dayType, name, idx, nil
+}
+
+// fillHolidayInfo 根据日期类型填充节假日信息，info 原有内容会被全部覆盖
+func fillHolidayInfo(info *HolidayInfo, date time.Time, dayType DayType, name string) {
+	*info = HolidayInfo{
+		Date:        date,
+		Weekday:     date.Weekday(),
+		HolidayName: name,
+	}
+	switch dayType {
+	case DayTypeAdjustedWorkday:
+		info.IsWorkday = true
+		info.IsAdjustedWorkday = true
+	case DayTypePublicHoliday:
+		info.IsHoliday = true
+	case DayTypeInLieu:
+		info.IsHoliday = true
+		info.IsInLieuDay = true
+	case DayTypeWeekend:
 		info.IsHoliday = true
 		info.IsWeekend = true
-		return info, nil
+	case DayTypeWorkday:
+		info.IsWorkday = true
+	default:
+		info.IsWorkday = dayType.IsWorkday()
+		info.IsHoliday = !info.IsWorkday
+		info.CustomType = dayType
 	}
-
-	// 普通工作日
-	info.IsWorkday = true
-	return info, nil
 }
 
 // HolidayInfo 节假日详细信息
 type HolidayInfo struct {
 	Date              time.Time
 	Weekday           time.Weekday
-	IsWorkday         bool   // 是否是工作日
-	IsHoliday         bool   // 是否是节假日
-	IsWeekend         bool   // 是否是周末
-	IsAdjustedWorkday bool   // 是否是调休工作日
-	IsInLieuDay       bool   // 是否是补休日
-	HolidayName       string // 节假日名称
+	IsWorkday         bool    // 是否是工作日
+	IsHoliday         bool    // 是否是节假日
+	IsWeekend         bool    // 是否是周末
+	IsAdjustedWorkday bool    // 是否是调休工作日
+	IsInLieuDay       bool    // 是否是补休日
+	HolidayName       string  // 节假日名称
+	Heuristic         bool    // 是否是缺少数据时按周末推断的结果
+	Source            Source  // 得出该结果的数据来源
+	DataVersion       int     // 数据版本，即该年份变更记录的序号，推断或覆盖结果为 0
+	CustomType        DayType // 日历层或覆盖规则定义的自定义日期类型，其他情况为 0
 }
 
 // String 格式化输出节假日信息
@@ -379,19 +802,35 @@ func (h *HolidayInfo) String() string {
 }
 
 // 全局默认检查器
-var defaultChecker = NewChecker()
+var defaultChecker atomic.Pointer[Checker]
+
+func init() {
+	defaultChecker.Store(NewChecker())
+}
+
+// DefaultChecker 返回包级函数使用的默认检查器
+func DefaultChecker() *Checker {
+	return defaultChecker.Load()
+}
+
+// SetDefaultChecker 替换包级函数使用的默认检查器，checker 为 nil 时忽略
+func SetDefaultChecker(checker *Checker) {
+	if checker != nil {
+		defaultChecker.Store(checker)
+	}
+}
 
 // IsHoliday 使用默认检查器判断是否是节假日
 func IsHoliday(date time.Time) (bool, string, error) {
-	return defaultChecker.IsHoliday(date)
+	return DefaultChecker().IsHoliday(date)
 }
 
 // IsWorkday 使用默认检查器判断是否是工作日
 func IsWorkday(date time.Time) (bool, error) {
-	return defaultChecker.IsWorkday(date)
+	return DefaultChecker().IsWorkday(date)
 }
 
 // GetHolidayInfo 使用默认检查器获取节假日信息
 func GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
-	return defaultChecker.GetHolidayInfo(date)
+	return DefaultChecker().GetHolidayInfo(date)
 }
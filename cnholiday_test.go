@@ -1,8 +1,13 @@
 package cnholiday
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -15,7 +20,7 @@ func TestNewChecker(t *testing.T) {
 	if checker.cache == nil {
 		t.Error("cache not initialized")
 	}
-	if checker.config.CDNBaseURL == "" {
+	if checker.settings().CDNBaseURL == "" {
 		t.Error("CDNBaseURL not set to default")
 	}
 }
@@ -27,13 +32,13 @@ func TestNewCheckerWithConfig(t *testing.T) {
 		CDNBaseURL:    "https://custom.cdn.com",
 	}
 	checker := NewCheckerWithConfig(config)
-	if checker.config.LocalDataDir != "./testdata" {
+	if checker.settings().LocalDataDir != "./testdata" {
 		t.Error("LocalDataDir not set correctly")
 	}
-	if !checker.config.DisableRemote {
+	if !checker.settings().DisableRemote {
 		t.Error("DisableRemote not set correctly")
 	}
-	if checker.config.CDNBaseURL != "https://custom.cdn.com" {
+	if checker.settings().CDNBaseURL != "https://custom.cdn.com" {
 		t.Error("CDNBaseURL not set correctly")
 	}
 }
@@ -222,6 +227,67 @@ func TestGetHolidayInfo(t *testing.T) {
 	}
 }
 
+func TestGetHolidayInfoInLieuOnly(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	// 补休日只出现在 inLieuDays 中，未同时写入 holidays
+	jsonData := []byte(`{
+		"holidays": {"2026-01-01": "元旦"},
+		"workdays": {"2026-01-04": "元旦"},
+		"inLieuDays": {"2026-01-02": "元旦"}
+	}`)
+	if err := checker.LoadYearFromJSON(2026, jsonData); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	date := time.Date(2026, 1, 2, 0, 0, 0, 0, time.Local)
+	info, err := checker.GetHolidayInfo(date)
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if !info.IsInLieuDay || !info.IsHoliday || info.IsWorkday || info.HolidayName != "元旦" {
+		t.Errorf("GetHolidayInfo(2026-01-02) = %+v, want in-lieu holiday 元旦", info)
+	}
+	if inLieu, err := checker.IsInLieuDay(date); err != nil || !inLieu {
+		t.Errorf("IsInLieuDay(2026-01-02) = %v, %v, want true", inLieu, err)
+	}
+}
+
+func TestGetHolidayInfoProvenance(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	date := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+
+	info, err := checker.GetHolidayInfo(date)
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if info.Source != SourceEmbedded || info.DataVersion != 1 {
+		t.Errorf("provenance = %s v%d, want embedded v1", info.Source, info.DataVersion)
+	}
+
+	if _, err := checker.PatchYear(2026, &HolidayData{Workdays: map[string]string{"2026-10-10": ""}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	info, _ = checker.GetHolidayInfo(date)
+	if info.Source != SourcePatch || info.DataVersion != 2 {
+		t.Errorf("provenance = %s v%d, want patch v2", info.Source, info.DataVersion)
+	}
+
+	// 历史视图返回当时的数据版本
+	view, err := checker.AsOf(checker.History(2026)[0].Time).GetHolidayInfo(date)
+	if err != nil {
+		t.Fatalf("AsOf GetHolidayInfo failed: %v", err)
+	}
+	if view.Source != SourceEmbedded || view.DataVersion != 1 {
+		t.Errorf("AsOf provenance = %s v%d, want embedded v1", view.Source, view.DataVersion)
+	}
+
+	heuristic := NewCheckerWithConfig(Config{DisableRemote: true, HeuristicFallback: true})
+	info, _ = heuristic.GetHolidayInfo(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC))
+	if info.Source != SourceHeuristic || info.DataVersion != 0 {
+		t.Errorf("provenance = %s v%d, want heuristic v0", info.Source, info.DataVersion)
+	}
+}
+
 func TestLoadYearFromLocal(t *testing.T) {
 	// 创建临时测试目录
 	tmpDir := t.TempDir()
@@ -257,6 +323,68 @@ func TestLoadYearFromLocal(t *testing.T) {
 	}
 }
 
+func TestSourceOrder(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"holidays": {}, "workdays": {}, "inLieuDays": {}}`))
+	}))
+	defer server.Close()
+
+	// 嵌入数据优先，远程作为最后的手段
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:  server.URL,
+		SourceOrder: []Source{SourceLocal, SourceEmbedded, SourceRemote},
+	})
+	if got := checker.sources(); !reflect.DeepEqual(got, []Source{SourceEmbedded, SourceRemote}) {
+		t.Errorf("sources = %v", got)
+	}
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if report, _ := checker.LoadReport(2026); report.Source != SourceEmbedded || requests.Load() != 0 {
+		t.Errorf("source = %s, remote requests = %d", report.Source, requests.Load())
+	}
+	if err := checker.LoadYear(2099); err != nil {
+		t.Fatalf("LoadYear(2099) failed: %v", err)
+	}
+	if report, _ := checker.LoadReport(2099); report.Source != SourceRemote || requests.Load() != 1 {
+		t.Errorf("source = %s, remote requests = %d", report.Source, requests.Load())
+	}
+
+	// 未列出的数据源不会被使用
+	remoteOnly := NewCheckerWithConfig(Config{DisableRemote: true, SourceOrder: []Source{SourceRemote}})
+	var loadErr *YearLoadError
+	if err := remoteOnly.LoadYear(2026); !errors.As(err, &loadErr) || len(loadErr.Errors) != 0 {
+		t.Errorf("LoadYear error = %v, want no sources", err)
+	}
+}
+
+func TestRemoteURLTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/holidays/2099/data.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"holidays": {"2099-01-01": "元旦"}, "workdays": {}, "inLieuDays": {}}`))
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:        "https://ignored.example.com",
+		RemoteURLTemplate: server.URL + "/holidays/{year}/data.json",
+	})
+	if got, want := checker.remoteURL(2099), server.URL+"/holidays/2099/data.json"; got != want {
+		t.Errorf("remoteURL = %s, want %s", got, want)
+	}
+	if err := checker.LoadYear(2099); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if report, _ := checker.LoadReport(2099); report.Source != SourceRemote {
+		t.Errorf("source = %s, want remote", report.Source)
+	}
+}
+
 func TestLoadYearError(t *testing.T) {
 	// 测试既没有远程也没有本地数据的情况
 	checker := NewCheckerWithConfig(Config{
@@ -264,12 +392,52 @@ func TestLoadYearError(t *testing.T) {
 		DisableRemote: true,
 	})
 
-	err := checker.LoadYear(2026)
+	// 嵌入数据不包含 1990 年
+	err := checker.LoadYear(1990)
 	if err == nil {
 		t.Error("Expected error when loading non-existent year")
 	}
 }
 
+func TestYearBounds(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL})
+	for _, year := range []int{-5, 0, 1990, 2200} {
+		err := checker.LoadYear(year)
+		var rangeErr *YearRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Min != DefaultMinYear || rangeErr.Max != DefaultMaxYear {
+			t.Errorf("LoadYear(%d) = %v, want *YearRangeError", year, err)
+		}
+	}
+	if _, err := checker.IsWorkday(time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error for year out of range")
+	}
+	if requests != 0 {
+		t.Errorf("out-of-range years sent %d remote requests", requests)
+	}
+
+	// 显式提供的数据不受范围限制
+	if err := checker.LoadYearFromJSON(1990, []byte(`{"holidays":{"1990-10-01":"国庆节"},"workdays":{}}`)); err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+	if isHoliday, _, err := checker.IsHoliday(time.Date(1990, 10, 1, 0, 0, 0, 0, time.UTC)); err != nil || !isHoliday {
+		t.Errorf("IsHoliday(1990-10-01) = %v, %v; want true", isHoliday, err)
+	}
+
+	// 开启推断后超出范围的年份按周末作答并标记
+	heuristic := NewCheckerWithConfig(Config{DisableRemote: true, HeuristicFallback: true, MinYear: 2020, MaxYear: 2030})
+	info, err := heuristic.GetHolidayInfo(time.Date(2031, 1, 4, 0, 0, 0, 0, time.UTC))
+	if err != nil || !info.Heuristic || !info.IsWeekend {
+		t.Errorf("GetHolidayInfo(2031-01-04) = %+v, %v; want heuristic weekend", info, err)
+	}
+}
+
 func TestCacheOperations(t *testing.T) {
 	checker := NewChecker()
 
@@ -313,13 +481,13 @@ func TestSetters(t *testing.T) {
 
 	// 测试 SetLocalDataDir
 	checker.SetLocalDataDir("./testdata")
-	if checker.config.LocalDataDir != "./testdata" {
+	if checker.settings().LocalDataDir != "./testdata" {
 		t.Error("SetLocalDataDir failed")
 	}
 
 	// 测试 SetDisableRemote
 	checker.SetDisableRemote(true)
-	if !checker.config.DisableRemote {
+	if !checker.settings().DisableRemote {
 		t.Error("SetDisableRemote failed")
 	}
 }
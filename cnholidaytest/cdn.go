@@ -0,0 +1,109 @@
+package cnholidaytest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CDNServer 模拟节假日数据 CDN 的测试服务器，用于在不访问网络的情况下测试完整的数据源回退链路
+// 以 /{year}.json 提供数据，不存在的年份返回 404，支持 ETag/If-None-Match，可以模拟慢响应和故障
+//
+//	cdn := cnholidaytest.NewCDNServer(map[int][]byte{2026: data})
+//	defer cdn.Close()
+//	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{CDNBaseURL: cdn.URL})
+type CDNServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	data     map[int][]byte
+	delay    time.Duration
+	status   int
+	requests int
+}
+
+// NewCDNServer 启动提供 data 中各年份数据的测试服务器，调用方负责 Close
+func NewCDNServer(data map[int][]byte) *CDNServer {
+	s := &CDNServer{data: make(map[int][]byte, len(data))}
+	for year, body := range data {
+		s.data[year] = body
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// SetYear 设置或替换某一年份的数据
+func (s *CDNServer) SetYear(year int, body []byte) {
+	s.mu.Lock()
+	s.data[year] = body
+	s.mu.Unlock()
+}
+
+// RemoveYear 删除某一年份的数据，之后请求该年份返回 404
+func (s *CDNServer) RemoveYear(year int) {
+	s.mu.Lock()
+	delete(s.data, year)
+	s.mu.Unlock()
+}
+
+// SetDelay 设置每个请求的响应延迟，用于测试超时；请求被取消时提前返回
+func (s *CDNServer) SetDelay(d time.Duration) {
+	s.mu.Lock()
+	s.delay = d
+	s.mu.Unlock()
+}
+
+// SetStatus 使所有请求返回指定的状态码(如 503)，0 表示恢复正常
+func (s *CDNServer) SetStatus(code int) {
+	s.mu.Lock()
+	s.status = code
+	s.mu.Unlock()
+}
+
+// Requests 返回服务器收到的请求数
+func (s *CDNServer) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *CDNServer) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	delay, status := s.delay, s.status
+	year, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), ".json"))
+	body, ok := s.data[year]
+	s.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if status != 0 {
+		w.WriteHeader(status)
+		return
+	}
+	if err != nil || !strings.HasSuffix(r.URL.Path, ".json") || !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:8]))
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
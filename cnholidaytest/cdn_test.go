@@ -0,0 +1,84 @@
+package cnholidaytest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/luojiego/cnholiday"
+)
+
+const cdnYearJSON = `{"holidays":{"2030-01-01":"New Year's Day,元旦,1"},"workdays":{}}`
+
+func TestCDNServerFallbackChain(t *testing.T) {
+	cdn := NewCDNServer(map[int][]byte{2030: []byte(cdnYearJSON)})
+	defer cdn.Close()
+
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{CDNBaseURL: cdn.URL, RemoteTimeout: 50 * time.Millisecond})
+	if err := checker.LoadYear(2030); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if report, _ := checker.LoadReport(2030); report.Source != cnholiday.SourceRemote {
+		t.Errorf("Source = %s, want remote", report.Source)
+	}
+
+	// CDN 上没有 2026 年数据，回退到内置数据
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if report, _ := checker.LoadReport(2026); report.Source != cnholiday.SourceEmbedded || len(report.Attempts) != 2 {
+		t.Errorf("report = %+v, want embedded after a failed remote attempt", report)
+	}
+
+	// 慢响应超时后同样回退
+	cdn.SetYear(2026, []byte(`{"holidays":{},"workdays":{}}`))
+	cdn.SetDelay(time.Second)
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if report, _ := checker.LoadReport(2026); report.Source != cnholiday.SourceEmbedded {
+		t.Errorf("Source = %s after timeout, want embedded", report.Source)
+	}
+
+	cdn.SetDelay(0)
+	cdn.SetStatus(http.StatusServiceUnavailable)
+	if err := checker.LoadYear(2030); err == nil {
+		t.Error("expected error: 2030 is neither on the failing CDN nor embedded")
+	}
+	if cdn.Requests() != 4 {
+		t.Errorf("Requests() = %d, want 4", cdn.Requests())
+	}
+}
+
+func TestCDNServerETag(t *testing.T) {
+	cdn := NewCDNServer(map[int][]byte{2030: []byte(cdnYearJSON)})
+	defer cdn.Close()
+
+	resp, err := http.Get(cdn.URL + "/2030.json")
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	resp.Body.Close()
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("missing ETag")
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, cdn.URL+"/2030.json", nil)
+	req.Header.Set("If-None-Match", etag)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("status = %d, want 304", resp.StatusCode)
+	}
+
+	cdn.RemoveYear(2030)
+	resp, _ = http.Get(cdn.URL + "/2030.json")
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", resp.StatusCode)
+	}
+}
@@ -0,0 +1,102 @@
+package cnholidaytest
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/luojiego/cnholiday"
+)
+
+// ConfigCenter 内存实现的配置中心，实现 cnholiday.ConfigCenter，用于测试配置中心数据源与热更新
+// Set、Delete 会同步调用监听该键的回调
+//
+//	center := cnholidaytest.NewConfigCenter()
+//	center.Set("cnholiday/2026.json", data)
+//	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{ConfigCenter: center})
+type ConfigCenter struct {
+	mu       sync.Mutex
+	values   map[string][]byte
+	watchers map[string][]*watcher
+	gets     int
+}
+
+type watcher struct {
+	ctx      context.Context
+	onChange func([]byte)
+}
+
+var _ cnholiday.ConfigCenter = (*ConfigCenter)(nil)
+
+// NewConfigCenter 创建空的内存配置中心
+func NewConfigCenter() *ConfigCenter {
+	return &ConfigCenter{
+		values:   make(map[string][]byte),
+		watchers: make(map[string][]*watcher),
+	}
+}
+
+// Get 实现 cnholiday.ConfigCenter
+func (c *ConfigCenter) Get(ctx context.Context, key string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gets++
+	value, ok := c.values[key]
+	if !ok {
+		return nil, cnholiday.ErrKeyNotFound
+	}
+	return slices.Clone(value), nil
+}
+
+// Watch 实现 cnholiday.ConfigCenter，ctx 取消后不再回调
+func (c *ConfigCenter) Watch(ctx context.Context, key string, onChange func(value []byte)) error {
+	w := &watcher{ctx: ctx, onChange: onChange}
+	c.mu.Lock()
+	c.watchers[key] = append(c.watchers[key], w)
+	c.mu.Unlock()
+
+	context.AfterFunc(ctx, func() {
+		c.mu.Lock()
+		c.watchers[key] = slices.DeleteFunc(c.watchers[key], func(x *watcher) bool { return x == w })
+		c.mu.Unlock()
+	})
+	return nil
+}
+
+// Set 设置键的值并通知监听者
+func (c *ConfigCenter) Set(key string, value []byte) {
+	c.mu.Lock()
+	c.values[key] = slices.Clone(value)
+	watchers := slices.Clone(c.watchers[key])
+	c.mu.Unlock()
+
+	for _, w := range watchers {
+		if w.ctx.Err() == nil {
+			w.onChange(slices.Clone(value))
+		}
+	}
+}
+
+// Delete 删除键并以 nil 值通知监听者
+func (c *ConfigCenter) Delete(key string) {
+	c.mu.Lock()
+	delete(c.values, key)
+	watchers := slices.Clone(c.watchers[key])
+	c.mu.Unlock()
+
+	for _, w := range watchers {
+		if w.ctx.Err() == nil {
+			w.onChange(nil)
+		}
+	}
+}
+
+// Gets 返回 Get 被调用的次数
+func (c *ConfigCenter) Gets() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.gets
+}
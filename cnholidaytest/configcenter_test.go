@@ -0,0 +1,90 @@
+package cnholidaytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luojiego/cnholiday"
+)
+
+func TestConfigCenterSource(t *testing.T) {
+	center := NewConfigCenter()
+	center.Set("holidays/2030", []byte(cdnYearJSON))
+
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{
+		DisableRemote:   true,
+		ConfigCenter:    center,
+		ConfigCenterKey: "holidays/{year}",
+	})
+	if err := checker.LoadYear(2030); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if report, _ := checker.LoadReport(2030); report.Source != cnholiday.SourceConfigCenter || center.Gets() != 1 {
+		t.Errorf("Source = %s, gets = %d; want config_center read once", report.Source, center.Gets())
+	}
+
+	// 配置中心没有该年份时回退到内置数据
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	report, _ := checker.LoadReport(2026)
+	if report.Source != cnholiday.SourceEmbedded || len(report.Attempts) != 2 || !errors.Is(report.Attempts[0].Err, cnholiday.ErrKeyNotFound) {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestConfigCenterWatch(t *testing.T) {
+	center := NewConfigCenter()
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{DisableRemote: true, ConfigCenter: center})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := checker.WatchConfigCenter(ctx, 2026); err != nil {
+		t.Fatalf("WatchConfigCenter failed: %v", err)
+	}
+
+	date := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	center.Set("cnholiday/2026.json", []byte(`{"holidays":{"2026-10-12":"公司假期"},"workdays":{},"inLieuDays":{}}`))
+	if isHoliday, name, _ := checker.IsHoliday(date); !isHoliday || name != "公司假期" {
+		t.Errorf("IsHoliday after push = %v, %q", isHoliday, name)
+	}
+
+	// 无效数据与删除都不影响缓存
+	center.Set("cnholiday/2026.json", []byte(`{"holidays":`))
+	center.Delete("cnholiday/2026.json")
+	if isHoliday, _, _ := checker.IsHoliday(date); !isHoliday {
+		t.Error("invalid push should be quarantined")
+	}
+	if report, _ := checker.LoadReport(2026); len(report.Quarantined()) != 1 {
+		t.Errorf("report = %+v, want one quarantined push", report)
+	}
+
+	// 取消后不再更新
+	cancel()
+	center.Set("cnholiday/2026.json", []byte(`{"holidays":{},"workdays":{},"inLieuDays":{}}`))
+	if isHoliday, _, _ := checker.IsHoliday(date); !isHoliday {
+		t.Error("updates after cancel should be ignored")
+	}
+
+	if err := cnholiday.NewChecker().WatchConfigCenter(ctx, 2026); err == nil {
+		t.Error("expected error without ConfigCenter")
+	}
+}
+
+func TestConfigCenterWatchStopsOnClose(t *testing.T) {
+	center := NewConfigCenter()
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{DisableRemote: true, ConfigCenter: center})
+	if err := checker.WatchConfigCenter(context.Background(), 2026); err != nil {
+		t.Fatalf("WatchConfigCenter failed: %v", err)
+	}
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	checker.Close()
+
+	center.Set("cnholiday/2026.json", []byte(`{"holidays":{"2026-10-12":"公司假期"},"workdays":{},"inLieuDays":{}}`))
+	if isHoliday, _, _ := checker.IsHoliday(time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)); isHoliday {
+		t.Error("updates after Close should be ignored")
+	}
+}
@@ -0,0 +1,113 @@
+// Package cnholidaytest 提供测试 cnholiday 使用方代码的辅助工具
+package cnholidaytest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/luojiego/cnholiday"
+)
+
+// Fake 内存中的 HolidayChecker 实现，用于单元测试
+// 未设置的日期按周六、周日为周末，其余为工作日
+type Fake struct {
+	mu   sync.RWMutex
+	days map[string]fakeDay
+	err  error
+}
+
+type fakeDay struct {
+	dayType cnholiday.DayType
+	name    string
+}
+
+var _ cnholiday.HolidayChecker = (*Fake)(nil)
+
+// NewFake 创建空的 Fake
+func NewFake() *Fake {
+	return &Fake{days: make(map[string]fakeDay)}
+}
+
+// Set 设置指定日期的类型及节日名称，返回 f 以便链式调用
+func (f *Fake) Set(date time.Time, dayType cnholiday.DayType, name string) *Fake {
+	f.mu.Lock()
+	f.days[date.Format("2006-01-02")] = fakeDay{dayType: dayType, name: name}
+	f.mu.Unlock()
+	return f
+}
+
+// Fail 使之后的所有查询返回 err，err 为 nil 时恢复正常
+func (f *Fake) Fail(err error) {
+	f.mu.Lock()
+	f.err = err
+	f.mu.Unlock()
+}
+
+// Classify 返回指定日期的类型及节日名称
+func (f *Fake) Classify(date time.Time) (cnholiday.DayType, string, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.err != nil {
+		return cnholiday.DayTypeWorkday, "", f.err
+	}
+	if day, ok := f.days[date.Format("2006-01-02")]; ok {
+		return day.dayType, day.name, nil
+	}
+	if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+		return cnholiday.DayTypeWeekend, "", nil
+	}
+	return cnholiday.DayTypeWorkday, "", nil
+}
+
+// IsHoliday 判断指定日期是否是节假日(休息日)，与 Checker.IsHoliday 的返回值一致
+func (f *Fake) IsHoliday(date time.Time) (bool, string, error) {
+	dayType, name, err := f.Classify(date)
+	if err != nil {
+		return false, "", err
+	}
+	if dayType == cnholiday.DayTypeWeekend {
+		name = "周末"
+	}
+	if dayType == cnholiday.DayTypeWorkday {
+		name = ""
+	}
+	return !dayType.IsWorkday(), name, nil
+}
+
+// IsWorkday 判断指定日期是否是工作日
+func (f *Fake) IsWorkday(date time.Time) (bool, error) {
+	dayType, _, err := f.Classify(date)
+	if err != nil {
+		return false, err
+	}
+	return dayType.IsWorkday(), nil
+}
+
+// GetHolidayInfo 获取节假日详细信息
+func (f *Fake) GetHolidayInfo(date time.Time) (*cnholiday.HolidayInfo, error) {
+	dayType, name, err := f.Classify(date)
+	if err != nil {
+		return nil, err
+	}
+	return &cnholiday.HolidayInfo{
+		Date:              date,
+		Weekday:           date.Weekday(),
+		IsWorkday:         dayType.IsWorkday(),
+		IsHoliday:         !dayType.IsWorkday(),
+		IsWeekend:         dayType == cnholiday.DayTypeWeekend,
+		IsAdjustedWorkday: dayType == cnholiday.DayTypeAdjustedWorkday,
+		IsInLieuDay:       dayType == cnholiday.DayTypeInLieu,
+		HolidayName:       name,
+	}, nil
+}
+
+// CountWorkdaysBetween 统计 [start, end] 闭区间内的工作日天数
+func (f *Fake) CountWorkdaysBetween(start, end time.Time) (int, error) {
+	return cnholiday.CountWorkdays(f.IsWorkday, start, end)
+}
+
+// AddWorkdays 返回 date 之后第 n 个工作日(不含当天)，n 为负数时向前查找
+func (f *Fake) AddWorkdays(date time.Time, n int) (time.Time, error) {
+	return cnholiday.StepWorkdays(f.IsWorkday, date, n)
+}
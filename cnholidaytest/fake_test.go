@@ -0,0 +1,42 @@
+package cnholidaytest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/luojiego/cnholiday"
+)
+
+func TestFake(t *testing.T) {
+	fake := NewFake().
+		Set(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), cnholiday.DayTypePublicHoliday, "元旦").
+		Set(time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC), cnholiday.DayTypeAdjustedWorkday, "元旦")
+
+	isHoliday, name, err := fake.IsHoliday(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil || !isHoliday || name != "元旦" {
+		t.Errorf("IsHoliday() = %v, %q, %v; want true, 元旦", isHoliday, name, err)
+	}
+
+	info, _ := fake.GetHolidayInfo(time.Date(2030, 1, 5, 0, 0, 0, 0, time.UTC))
+	if !info.IsWorkday || !info.IsAdjustedWorkday {
+		t.Errorf("info = %+v, want adjusted workday", info)
+	}
+
+	// 2030-01-01 周二至 01-06 周日：元旦放假，周六调休上班，周日休息
+	count, err := fake.CountWorkdaysBetween(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2030, 1, 6, 0, 0, 0, 0, time.UTC))
+	if err != nil || count != 4 {
+		t.Errorf("CountWorkdaysBetween() = %d, %v; want 4", count, err)
+	}
+
+	next, err := fake.AddWorkdays(time.Date(2029, 12, 31, 0, 0, 0, 0, time.UTC), 1)
+	if err != nil || next.Format("2006-01-02") != "2030-01-02" {
+		t.Errorf("AddWorkdays() = %s, %v; want 2030-01-02", next.Format("2006-01-02"), err)
+	}
+
+	failure := errors.New("data unavailable")
+	fake.Fail(failure)
+	if _, err := fake.IsWorkday(time.Now()); !errors.Is(err, failure) {
+		t.Errorf("IsWorkday() error = %v, want %v", err, failure)
+	}
+}
@@ -0,0 +1,41 @@
+package cnholiday
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// maxDecompressedSize 解压后数据的最大字节数，防止异常数据耗尽内存
+const maxDecompressedSize = 16 << 20
+
+// gzipMagic gzip 数据的魔数
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// isGzip 判断数据是否为 gzip 格式
+func isGzip(data []byte) bool {
+	return bytes.HasPrefix(data, gzipMagic)
+}
+
+// maybeGunzip 若数据为 gzip 格式则解压，否则原样返回
+func maybeGunzip(data []byte) ([]byte, error) {
+	if !isGzip(data) {
+		return data, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("解压 gzip 数据失败: %w", err)
+	}
+	defer reader.Close()
+
+	out, err := io.ReadAll(io.LimitReader(reader, maxDecompressedSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("解压 gzip 数据失败: %w", err)
+	}
+	if len(out) > maxDecompressedSize {
+		return nil, fmt.Errorf("解压后数据超过 %d 字节", maxDecompressedSize)
+	}
+	return out, nil
+}
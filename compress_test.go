@@ -0,0 +1,100 @@
+package cnholiday
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		t.Fatalf("gzip write failed: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("gzip close failed: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestMaybeGunzip(t *testing.T) {
+	plain := []byte(emptyYearJSON)
+
+	out, err := maybeGunzip(plain)
+	if err != nil || !bytes.Equal(out, plain) {
+		t.Fatalf("plain data should be returned unchanged, got %q, %v", out, err)
+	}
+
+	out, err = maybeGunzip(gzipBytes(t, plain))
+	if err != nil || !bytes.Equal(out, plain) {
+		t.Fatalf("gzip data should be decompressed, got %q, %v", out, err)
+	}
+
+	if _, err := maybeGunzip([]byte{0x1f, 0x8b, 0x00}); err == nil {
+		t.Error("expected error for corrupted gzip data")
+	}
+}
+
+func TestRemoteGzip(t *testing.T) {
+	yearJSON := []byte(`{"holidays":{"2026-01-01":"元旦"},"workdays":{},"inLieuDays":{}}`)
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"content-encoding", func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Accept-Encoding") != "gzip" {
+				t.Errorf("Accept-Encoding = %q, want gzip", r.Header.Get("Accept-Encoding"))
+			}
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Write(gzipBytes(t, yearJSON))
+		}},
+		{"gzip-artifact", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/gzip")
+			w.Write(gzipBytes(t, yearJSON))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL})
+			data, err := checker.loadYearFromRemote(context.Background(), 2026)
+			if err != nil {
+				t.Fatalf("remote load failed: %v", err)
+			}
+			if data.Holidays["2026-01-01"] != "元旦" {
+				t.Errorf("unexpected holidays: %v", data.Holidays)
+			}
+		})
+	}
+}
+
+func TestLoadYearFromLocalGzip(t *testing.T) {
+	tmpDir := t.TempDir()
+	yearJSON := []byte(`{"holidays":{"2026-01-01":"元旦"},"workdays":{},"inLieuDays":{}}`)
+	if err := os.WriteFile(filepath.Join(tmpDir, "2026.json.gz"), gzipBytes(t, yearJSON), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	checker := NewCheckerWithConfig(Config{
+		LocalDataDir:  tmpDir,
+		DisableRemote: true,
+	})
+	data, err := checker.loadYearFromLocal(context.Background(), 2026)
+	if err != nil {
+		t.Fatalf("load gzip local file failed: %v", err)
+	}
+	if data.Holidays["2026-01-01"] != "元旦" {
+		t.Errorf("unexpected holidays: %v", data.Holidays)
+	}
+}
@@ -0,0 +1,182 @@
+package cnholiday
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 并发压力测试，需配合 -race 运行才能发现数据竞争：
+//
+//	go test -race -run Concurrent
+
+// stressGoroutines 每个测试启动的 goroutine 数量，-short 模式下减少
+func stressGoroutines() int {
+	if testing.Short() {
+		return 200
+	}
+	return 2000
+}
+
+// runConcurrently 启动 n 个 goroutine 执行 fn，全部结束后返回
+func runConcurrently(n int, fn func(i int)) {
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			fn(i)
+		}()
+	}
+	close(start)
+	wg.Wait()
+}
+
+func TestConcurrentLoadClearQuery(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	years := []int{2024, 2025, 2026}
+
+	runConcurrently(stressGoroutines(), func(i int) {
+		year := years[i%len(years)]
+		date := time.Date(year, 10, 1+i%7, 0, 0, 0, 0, time.Local)
+		switch i % 10 {
+		case 0:
+			checker.ClearYear(year)
+		case 1:
+			if i%100 == 1 {
+				checker.ClearCache()
+			}
+		case 2:
+			if err := checker.LoadYear(year); err != nil {
+				t.Errorf("LoadYear(%d) failed: %v", year, err)
+			}
+		case 3:
+			checker.IsHolidayFast(date)
+		case 4:
+			if _, err := checker.GetHolidayInfo(date); err != nil {
+				t.Errorf("GetHolidayInfo(%v) failed: %v", date, err)
+			}
+		case 5:
+			if _, err := checker.AddWorkdays(date, 5); err != nil {
+				t.Errorf("AddWorkdays(%v) failed: %v", date, err)
+			}
+		case 6:
+			checker.LoadReport(year)
+			checker.IsYearLoaded(year)
+		case 7:
+			checker.Counters()
+		default:
+			holiday, _, err := checker.IsHoliday(date)
+			if err != nil {
+				t.Errorf("IsHoliday(%v) failed: %v", date, err)
+			}
+			// 10 月 1 日至 7 日在三个年份中都是国庆假期，清理缓存不应影响结果
+			if !holiday {
+				t.Errorf("IsHoliday(%v) = false, want true", date)
+			}
+		}
+	})
+}
+
+func TestConcurrentSetters(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+
+	runConcurrently(stressGoroutines(), func(i int) {
+		switch i % 5 {
+		case 0:
+			checker.SetLocalDataDir(fmt.Sprintf("testdata/%d", i%3))
+		case 1:
+			// 只切换为禁用，避免压力测试访问网络
+			checker.SetDisableRemote(true)
+		case 2:
+			checker.ClearYear(2026)
+		default:
+			if _, err := checker.IsWorkday(date); err != nil {
+				t.Errorf("IsWorkday(%v) failed: %v", date, err)
+			}
+		}
+	})
+}
+
+func TestConcurrentWrites(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	manager := NewTenantManager(checker)
+	events := make(chan ChangeEvent, 1)
+	cancel := checker.Subscribe(func(e ChangeEvent) {
+		select {
+		case events <- e:
+		default:
+		}
+	})
+	defer cancel()
+
+	runConcurrently(stressGoroutines(), func(i int) {
+		date := time.Date(2026, 5, 1+i%20, 0, 0, 0, 0, time.Local)
+		tenant := fmt.Sprintf("tenant-%d", i%4)
+		switch i % 12 {
+		case 0:
+			patch := &HolidayData{Workdays: map[string]string{date.Format("2006-01-02"): "压力测试"}}
+			if _, err := checker.PatchYear(2026, patch); err != nil {
+				t.Errorf("PatchYear failed: %v", err)
+			}
+		case 1:
+			checker.Import(checker.Export())
+		case 2:
+			checker.History(2026)
+		case 3:
+			layer := &Layer{Name: fmt.Sprintf("layer-%d", i%3), Periods: []LayerPeriod{
+				{Start: date, End: date.AddDate(0, 0, 3), Type: "stress", Name: "压力测试"},
+			}}
+			if err := checker.SetLayer(layer); err != nil {
+				t.Errorf("SetLayer failed: %v", err)
+			}
+		case 4:
+			checker.RemoveLayer(fmt.Sprintf("layer-%d", i%3))
+		case 5:
+			checker.Layers()
+		case 6:
+			manager.SetOverride(tenant, date, Override{IsHoliday: true, Name: "公司活动"})
+		case 7:
+			manager.RemoveOverride(tenant, date)
+		case 8:
+			if _, err := manager.ForTenant(tenant).IsWorkday(date); err != nil {
+				t.Errorf("tenant IsWorkday failed: %v", err)
+			}
+		case 9:
+			checker.Subscribe(func(ChangeEvent) {})()
+		case 10:
+			if _, err := checker.ListHolidays(2026); err != nil {
+				t.Errorf("ListHolidays failed: %v", err)
+			}
+		default:
+			if _, err := checker.GetHolidayInfo(date); err != nil {
+				t.Errorf("GetHolidayInfo(%v) failed: %v", date, err)
+			}
+		}
+	})
+}
+
+func TestConcurrentClose(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+
+	runConcurrently(stressGoroutines(), func(i int) {
+		switch i % 4 {
+		case 0:
+			if err := checker.Close(); err != nil {
+				t.Errorf("Close failed: %v", err)
+			}
+		case 1:
+			checker.Subscribe(func(ChangeEvent) {})
+		default:
+			// Close 之后查询仍然可用，只是不再启动后台任务
+			if _, _, err := checker.IsHoliday(date); err != nil {
+				t.Errorf("IsHoliday(%v) failed: %v", date, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package cnholiday
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfigError 配置存在矛盾或无效的设置，包含全部问题
+type ConfigError struct {
+	Issues []ValidationIssue // Path 为出问题的配置项名称
+}
+
+func (e *ConfigError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		msgs[i] = issue.String()
+	}
+	return fmt.Sprintf("配置无效: %s", strings.Join(msgs, "; "))
+}
+
+// Validate 检查配置中无效或相互矛盾的设置，返回包含全部问题的 *ConfigError
+// NewCheckerWithConfig 不会拒绝有问题的配置，建议在创建检查器前调用：
+//
+//	if err := config.Validate(); err != nil {
+//		log.Fatal(err)
+//	}
+func (config Config) Validate() error {
+	var issues []ValidationIssue
+	report := func(field, format string, args ...any) {
+		issues = append(issues, ValidationIssue{Path: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if config.CDNBaseURL != "" {
+		if err := checkHTTPURL(config.CDNBaseURL); err != nil {
+			report("CDNBaseURL", "%v", err)
+		}
+	}
+	if config.RemoteURLTemplate != "" {
+		if !strings.Contains(config.RemoteURLTemplate, yearPlaceholder) {
+			report("RemoteURLTemplate", "缺少年份占位符 %s", yearPlaceholder)
+		} else if err := checkHTTPURL(strings.ReplaceAll(config.RemoteURLTemplate, yearPlaceholder, "2026")); err != nil {
+			report("RemoteURLTemplate", "%v", err)
+		}
+	}
+	for _, raw := range config.PollURLs {
+		if err := checkHTTPURL(strings.ReplaceAll(raw, yearPlaceholder, "2026")); err != nil {
+			report("PollURLs", "%v", err)
+		}
+	}
+	if config.ProxyURL != "" {
+		if _, err := newHTTPClient(config); err != nil {
+			report("ProxyURL", "%v", err)
+		}
+	}
+
+	if auth := config.RemoteAuth; auth != nil && auth.BearerToken != "" && (auth.Username != "" || auth.Password != "") {
+		report("RemoteAuth", "BearerToken 与基本认证只能设置一种")
+	}
+
+	for i, key := range config.BundlePublicKeys {
+		if len(key) != ed25519.PublicKeySize {
+			report("BundlePublicKeys", "第 %d 个公钥长度为 %d 字节，应为 %d 字节", i+1, len(key), ed25519.PublicKeySize)
+		}
+	}
+	if config.ConfigCenterKey != "" && !strings.Contains(config.ConfigCenterKey, yearPlaceholder) {
+		report("ConfigCenterKey", "缺少年份占位符 %s", yearPlaceholder)
+	}
+
+	if config.LocalDataDir != "" {
+		info, err := os.Stat(config.LocalDataDir)
+		switch {
+		case err != nil:
+			report("LocalDataDir", "目录不可访问: %v", err)
+		case !info.IsDir():
+			report("LocalDataDir", "不是目录: %s", config.LocalDataDir)
+		}
+	}
+
+	for i, source := range config.SourceOrder {
+		switch {
+		case !knownSource(source):
+			report("SourceOrder", "未知的数据源: %q", source)
+		case slices.Contains(config.SourceOrder[:i], source):
+			report("SourceOrder", "数据源重复: %s", source)
+		}
+	}
+
+	if config.MinYear != 0 && config.MaxYear != 0 && config.MinYear > config.MaxYear {
+		report("MinYear", "最早年份 %d 晚于最晚年份 %d", config.MinYear, config.MaxYear)
+	}
+	if config.LocalTimeout < 0 {
+		report("LocalTimeout", "不能为负数")
+	}
+	if config.BreakerThreshold < 0 {
+		report("BreakerThreshold", "不能为负数")
+	}
+	if config.RemoteRateLimit < 0 || config.RemoteHostRateLimit < 0 {
+		report("RemoteRateLimit", "不能为负数")
+	}
+	if config.FiscalYearStart != 0 && (config.FiscalYearStart < time.January || config.FiscalYearStart > time.December) {
+		report("FiscalYearStart", "月份无效: %d", config.FiscalYearStart)
+	}
+	if config.OnHeuristic != nil && !config.HeuristicFallback {
+		report("OnHeuristic", "未开启 HeuristicFallback，回调不会被调用")
+	}
+
+	// 按生效的数据源检查是否还有可用的数据
+	defaults := config
+	defaults.applyDefaults()
+	effective := &Checker{}
+	effective.config.Store(&defaults)
+	sources := effective.sources()
+	if config.AsyncRefresh && !slices.Contains(sources, SourceRemote) {
+		report("AsyncRefresh", "远程数据源未启用，不会进行后台刷新")
+	}
+	switch {
+	case len(sources) == 0:
+		report("SourceOrder", "没有可用的数据源")
+	case len(sources) == 1 && sources[0] == SourceEmbedded:
+		// 只能使用嵌入数据时，当前年份必须有数据
+		if year := time.Now().Year(); !slices.Contains(embeddedYears(), year) {
+			report("DisableRemote", "仅能使用嵌入数据，但嵌入数据不包含 %d 年，请配置 LocalDataDir 或启用远程数据源", year)
+		}
+	}
+
+	if len(issues) > 0 {
+		return &ConfigError{Issues: issues}
+	}
+	return nil
+}
+
+// checkHTTPURL 检查远程数据地址是否是有效的 http(s) 地址
+func checkHTTPURL(raw string) error {
+	u, err := url.Parse(raw)
+	switch {
+	case err != nil:
+		return fmt.Errorf("地址无效: %w", err)
+	case u.Scheme != "http" && u.Scheme != "https":
+		return fmt.Errorf("仅支持 http 或 https 地址: %s", raw)
+	case u.Host == "":
+		return fmt.Errorf("地址缺少主机名: %s", raw)
+	}
+	return nil
+}
+
+// embeddedYears 返回嵌入数据包含的年份，按升序排列
+func embeddedYears() []int {
+	entries, _ := fs.ReadDir(embeddedData, "data")
+	var years []int
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		if year, err := strconv.Atoi(name); err == nil {
+			years = append(years, year)
+		}
+	}
+	return years
+}
@@ -0,0 +1,75 @@
+package cnholiday
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestConfigValidate(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "2026.json")
+	if err := os.WriteFile(file, []byte(`{}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	valid := []Config{
+		{},
+		{LocalDataDir: dir, DisableRemote: true},
+		{RemoteURLTemplate: "https://internal/holidays/{year}/data.json"},
+		{CDNBaseURL: "https://cdn.example.com/years", SourceOrder: []Source{SourceEmbedded, SourceRemote}, AsyncRefresh: true},
+	}
+	for _, config := range valid {
+		if err := config.Validate(); err != nil {
+			t.Errorf("Validate(%+v) = %v", config, err)
+		}
+	}
+
+	tests := []struct {
+		config Config
+		fields []string
+	}{
+		{Config{CDNBaseURL: "cdn.example.com/years"}, []string{"CDNBaseURL"}},
+		{Config{CDNBaseURL: "https:///years"}, []string{"CDNBaseURL"}},
+		{Config{RemoteURLTemplate: "https://internal/holidays/data.json"}, []string{"RemoteURLTemplate"}},
+		{Config{RemoteURLTemplate: "internal/holidays/{year}/data.json"}, []string{"RemoteURLTemplate"}},
+		{Config{ProxyURL: "://bad"}, []string{"ProxyURL"}},
+		{Config{ConfigCenterKey: "cnholiday/data.json"}, []string{"ConfigCenterKey"}},
+		{Config{RemoteAuth: &RemoteAuth{BearerToken: "t", Username: "u"}}, []string{"RemoteAuth"}},
+		{Config{LocalDataDir: filepath.Join(dir, "missing")}, []string{"LocalDataDir"}},
+		{Config{LocalDataDir: file}, []string{"LocalDataDir"}},
+		{Config{SourceOrder: []Source{SourceLocal, "s3", SourceLocal, SourceEmbedded}}, []string{"SourceOrder", "SourceOrder"}},
+		{Config{SourceOrder: []Source{SourceRemote}, DisableRemote: true}, []string{"SourceOrder"}},
+		{Config{MinYear: 2030, MaxYear: 2020, BreakerThreshold: -1}, []string{"MinYear", "BreakerThreshold"}},
+		{Config{OnHeuristic: func(time.Time, error) {}}, []string{"OnHeuristic"}},
+		{Config{AsyncRefresh: true, DisableRemote: true, LocalDataDir: dir}, []string{"AsyncRefresh"}},
+	}
+	for _, tt := range tests {
+		err := tt.config.Validate()
+		var cerr *ConfigError
+		if !errors.As(err, &cerr) {
+			t.Errorf("Validate(%+v) = %v, want *ConfigError", tt.config, err)
+			continue
+		}
+		var fields []string
+		for _, issue := range cerr.Issues {
+			fields = append(fields, issue.Path)
+		}
+		if !reflect.DeepEqual(fields, tt.fields) {
+			t.Errorf("Validate(%+v) fields = %v, want %v (%v)", tt.config, fields, tt.fields, err)
+		}
+	}
+
+	// 只能使用嵌入数据时，嵌入数据必须包含当前年份
+	err := Config{DisableRemote: true}.Validate()
+	if covered := slices.Contains(embeddedYears(), time.Now().Year()); covered != (err == nil) {
+		t.Errorf("Validate(DisableRemote) = %v, embedded covers current year: %v", err, covered)
+	}
+	if years := embeddedYears(); !slices.IsSorted(years) || !slices.Contains(years, 2024) || !slices.Contains(years, 2026) {
+		t.Errorf("embeddedYears = %v", years)
+	}
+}
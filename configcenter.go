@@ -0,0 +1,80 @@
+package cnholiday
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrKeyNotFound 配置中心中不存在请求的键，ConfigCenter 的实现在键不存在时应返回该错误或包装它的错误
+var ErrKeyNotFound = errors.New("配置项不存在")
+
+// ConfigCenter 配置中心客户端，etcd、Consul、Nacos、Apollo 等通过适配器实现该接口后作为数据源使用，
+// 每个年份的数据以与本地数据文件相同的 JSON 存放在一个键中
+type ConfigCenter interface {
+	// Get 返回键的当前值，键不存在时返回 ErrKeyNotFound
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Watch 监听键的变化，注册后立即返回；值变化时调用 onChange，键被删除时 value 为 nil。
+	// ctx 取消后停止监听
+	Watch(ctx context.Context, key string, onChange func(value []byte)) error
+}
+
+// defaultConfigCenterKey 未配置 ConfigCenterKey 时的键模板
+const defaultConfigCenterKey = "cnholiday/{year}.json"
+
+// configCenterKey 返回年份数据在配置中心中的键
+func (c *Checker) configCenterKey(year int) string {
+	return strings.ReplaceAll(c.settings().ConfigCenterKey, yearPlaceholder, strconv.Itoa(year))
+}
+
+// loadYearFromConfigCenter 从配置中心读取年份数据
+func (c *Checker) loadYearFromConfigCenter(ctx context.Context, year int) (*HolidayData, error) {
+	key := c.configCenterKey(year)
+	value, err := c.settings().ConfigCenter.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("读取配置项 %s 失败: %w", key, err)
+	}
+	return c.parseYear(SourceConfigCenter, year, value)
+}
+
+// WatchConfigCenter 监听配置中心中指定年份的数据，变更后立即替换缓存并通知订阅者，ctx 取消或 Close 后停止监听
+// 未通过解析或校验的新值会被隔离，继续使用缓存中的数据；键被删除时同样保留缓存
+func (c *Checker) WatchConfigCenter(ctx context.Context, years ...int) error {
+	if c.settings().ConfigCenter == nil {
+		return errors.New("未配置 ConfigCenter")
+	}
+
+	// Close 时同样停止监听
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(c.lifetime, cancel)
+	context.AfterFunc(ctx, func() { stop() })
+
+	for _, year := range years {
+		key := c.configCenterKey(year)
+		err := c.settings().ConfigCenter.Watch(ctx, key, func(value []byte) {
+			if value != nil && c.lifetime.Err() == nil {
+				c.applyConfigCenter(year, value)
+			}
+		})
+		if err != nil {
+			cancel()
+			return fmt.Errorf("监听配置项 %s 失败: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// applyConfigCenter 写入配置中心推送的年份数据
+func (c *Checker) applyConfigCenter(year int, value []byte) {
+	start := time.Now()
+	data, err := c.parseYear(SourceConfigCenter, year, value)
+	attempts := []LoadAttempt{{Source: SourceConfigCenter, Start: start, Duration: time.Since(start), Err: err}}
+	if err != nil {
+		c.recordFailure(year, attempts, &YearLoadError{Year: year, Errors: []*SourceError{{Source: SourceConfigCenter, Err: err}}})
+		return
+	}
+	c.store(year, data, SourceConfigCenter, attempts)
+}
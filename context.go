@@ -0,0 +1,17 @@
+package cnholiday
+
+import "context"
+
+// checkerKey context 中保存检查器的键
+type checkerKey struct{}
+
+// NewContext 返回携带指定检查器的 context，用于在请求处理链路中传递租户专属的检查器
+func NewContext(ctx context.Context, checker *Checker) context.Context {
+	return context.WithValue(ctx, checkerKey{}, checker)
+}
+
+// FromContext 取出 NewContext 保存的检查器，不存在时返回 false
+func FromContext(ctx context.Context) (*Checker, bool) {
+	checker, ok := ctx.Value(checkerKey{}).(*Checker)
+	return checker, ok && checker != nil
+}
@@ -0,0 +1,24 @@
+package cnholiday
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("FromContext should return false for empty context")
+	}
+
+	checker := NewChecker()
+	ctx := NewContext(context.Background(), checker)
+
+	got, ok := FromContext(ctx)
+	if !ok || got != checker {
+		t.Errorf("FromContext() = %p, %v; want %p, true", got, ok, checker)
+	}
+
+	if _, ok := FromContext(NewContext(context.Background(), nil)); ok {
+		t.Error("FromContext should return false for nil checker")
+	}
+}
@@ -0,0 +1,69 @@
+package cnholiday
+
+import "sync/atomic"
+
+// dayTypeCount 日期类型的数量，用于按结果类型统计查询
+const dayTypeCount = int(DayTypeInLieu) + 1
+
+// counters 检查器的统计计数，全部使用原子操作，不参与锁竞争
+type counters struct {
+	queries      [dayTypeCount]atomic.Uint64
+	queryErrors  atomic.Uint64
+	cacheHits    atomic.Uint64
+	cacheMisses  atomic.Uint64
+	loads        [len(sourceIndexes)]atomic.Uint64
+	loadFailures atomic.Uint64
+	heuristic    atomic.Uint64
+}
+
+// sourceIndexes 数据源在 counters.loads 中的下标
+var sourceIndexes = [...]Source{SourceRemote, SourceLocal, SourceEmbedded, SourceJSON, SourceImport, SourceNotice, SourceBundle}
+
+// Counters 检查器的统计计数快照
+type Counters struct {
+	Queries      map[DayType]uint64 // 按查询结果的日期类型统计的查询次数
+	QueryErrors  uint64             // 因数据加载失败而出错的查询次数
+	CacheHits    uint64             // 年份数据已缓存的查询次数
+	CacheMisses  uint64             // 需要加载年份数据的查询次数
+	Loads        map[Source]uint64  // 按数据源统计的成功加载次数
+	LoadFailures uint64             // 所有数据源均失败的加载次数
+	Heuristic    uint64             // 缺少数据时按周末推断作答的次数
+}
+
+// Counters 返回检查器自创建以来的统计计数
+// 各项计数分别原子读取，并发查询时快照内的各项之间可能存在细微偏差
+func (c *Checker) Counters() Counters {
+	snapshot := Counters{
+		Queries:      make(map[DayType]uint64, dayTypeCount),
+		QueryErrors:  c.counters.queryErrors.Load(),
+		CacheHits:    c.counters.cacheHits.Load(),
+		CacheMisses:  c.counters.cacheMisses.Load(),
+		Loads:        make(map[Source]uint64, len(sourceIndexes)),
+		LoadFailures: c.counters.loadFailures.Load(),
+		Heuristic:    c.counters.heuristic.Load(),
+	}
+	for i := range c.counters.queries {
+		snapshot.Queries[DayType(i)] = c.counters.queries[i].Load()
+	}
+	for i, source := range sourceIndexes {
+		snapshot.Loads[source] = c.counters.loads[i].Load()
+	}
+	return snapshot
+}
+
+// countQuery 记录一次成功查询的结果类型
+func (c *Checker) countQuery(t DayType) {
+	if int(t) < dayTypeCount {
+		c.counters.queries[t].Add(1)
+	}
+}
+
+// countLoad 记录一次成功加载
+func (c *Checker) countLoad(source Source) {
+	for i, s := range sourceIndexes {
+		if s == source {
+			c.counters.loads[i].Add(1)
+			return
+		}
+	}
+}
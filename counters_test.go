@@ -0,0 +1,62 @@
+package cnholiday
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCounters(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	if _, _, err := checker.IsHoliday(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("IsHoliday failed: %v", err)
+	}
+	if _, err := checker.IsWorkday(time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("IsWorkday failed: %v", err)
+	}
+	if _, _, err := checker.Classify(time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Classify failed: %v", err)
+	}
+	if _, err := checker.GetHolidayInfo(time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Fatal("expected error for year without data")
+	}
+
+	got := checker.Counters()
+	if got.Queries[DayTypePublicHoliday] != 1 || got.Queries[DayTypeAdjustedWorkday] != 1 || got.Queries[DayTypeWorkday] != 1 {
+		t.Errorf("Queries = %v", got.Queries)
+	}
+	if got.CacheHits != 2 || got.CacheMisses != 2 {
+		t.Errorf("CacheHits = %d, CacheMisses = %d; want 2, 2", got.CacheHits, got.CacheMisses)
+	}
+	if got.QueryErrors != 1 || got.LoadFailures != 1 {
+		t.Errorf("QueryErrors = %d, LoadFailures = %d; want 1, 1", got.QueryErrors, got.LoadFailures)
+	}
+	if got.Loads[SourceEmbedded] != 1 || got.Loads[SourceRemote] != 0 {
+		t.Errorf("Loads = %v", got.Loads)
+	}
+}
+
+func TestCountersConcurrent(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	const goroutines, perGoroutine = 8, 100
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				checker.IsHolidayFast(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := checker.Counters().Queries[DayTypePublicHoliday]; got != goroutines*perGoroutine {
+		t.Errorf("Queries[public_holiday] = %d, want %d", got, goroutines*perGoroutine)
+	}
+}
@@ -0,0 +1,91 @@
+package cnholiday
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 完整性评估使用的合理范围
+const (
+	maxFestivalDays     = 12 // 单个节日连续放假的最多天数(含周末)
+	minYearHolidayDays  = 20 // 全年放假天数(含假期内的周末)的下限
+	maxYearHolidayDays  = 45 // 全年放假天数的上限
+	maxYearAdjustedDays = 12 // 全年调休工作日的上限
+)
+
+// Coverage 年份数据的完整性评估
+type Coverage struct {
+	Year    int
+	Score   float64           // 通过的检查项占比，范围 0 ~ 1，1 表示未发现问题
+	Days    map[string]int    // 各法定节日的放假天数(含假期内的周末)，键为中文名称
+	Missing []string          // 缺少的法定节日，按时间顺序排列
+	Issues  []ValidationIssue // 未通过的检查项
+}
+
+// Complete 是否通过全部检查
+func (c Coverage) Complete() bool {
+	return len(c.Issues) == 0
+}
+
+// CoverageScore 评估年份数据是否完整：7 个法定节日是否齐全，各节日及全年的放假天数、调休天数是否在合理范围内，
+// 用于在截断或占位的数据文件导致错误判断之前发现问题。年份未加载时会先加载
+// 中秋与国庆合并放假且数据中未单独标注中秋时，若国庆假期不少于 8 天则视为包含中秋
+func (c *Checker) CoverageScore(year int) (Coverage, error) {
+	idx, err := c.index(year)
+	if err != nil {
+		return Coverage{}, err
+	}
+	return evaluateCoverage(year, idx.base.view()), nil
+}
+
+// evaluateCoverage 评估一个年份数据的完整性，data 为该年份自身的数据
+func evaluateCoverage(year int, data *HolidayData) Coverage {
+	cov := Coverage{Year: year, Days: make(map[string]int)}
+	checks := 0
+	check := func(ok bool, path, format string, args ...any) {
+		checks++
+		if !ok {
+			cov.Issues = append(cov.Issues, ValidationIssue{Path: path, Message: fmt.Sprintf(format, args...)})
+		}
+	}
+
+	// 名称格式为 "英文名,中文名,法定天数"，法定天数缺失时按 1 天计算
+	// 多个节日合并放假且名称连写时(例如 "国庆节、中秋节")，这一天同时计入各个节日
+	statutory := make(map[string]int)
+	for _, date := range sortedKeys(data.Holidays) {
+		for _, f := range NormalizeFestival(data.Holidays[date]) {
+			cov.Days[f.String()]++
+			if parts := strings.Split(data.Holidays[date], ","); len(parts) >= 3 {
+				if n, err := strconv.Atoi(parts[2]); err == nil {
+					statutory[f.String()] = n
+				}
+			}
+		}
+	}
+
+	for _, f := range festivals {
+		festival := f.String()
+		days := cov.Days[festival]
+		if days == 0 && festival == "中秋" && cov.Days["国庆节"] >= 8 {
+			continue
+		}
+		path := "holidays." + festival
+		check(days > 0, path, "缺少该节日")
+		if days == 0 {
+			cov.Missing = append(cov.Missing, festival)
+			continue
+		}
+		check(days >= max(statutory[festival], 1), path, "放假 %d 天，少于法定的 %d 天", days, statutory[festival])
+		check(days <= maxFestivalDays, path, "放假 %d 天，超过合理上限 %d 天", days, maxFestivalDays)
+	}
+
+	total := len(data.Holidays)
+	check(total >= minYearHolidayDays && total <= maxYearHolidayDays, "holidays",
+		"全年放假 %d 天，不在合理范围 %d ~ %d 天内", total, minYearHolidayDays, maxYearHolidayDays)
+	check(len(data.Workdays) <= maxYearAdjustedDays, "workdays",
+		"全年调休工作日 %d 天，超过合理上限 %d 天", len(data.Workdays), maxYearAdjustedDays)
+
+	cov.Score = float64(checks-len(cov.Issues)) / float64(checks)
+	return cov
+}
@@ -0,0 +1,98 @@
+package cnholiday
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCoverageScoreEmbedded(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	for _, year := range []int{2024, 2025, 2026} {
+		cov, err := checker.CoverageScore(year)
+		if err != nil {
+			t.Fatalf("CoverageScore(%d) failed: %v", year, err)
+		}
+		if !cov.Complete() || cov.Score != 1 {
+			t.Errorf("CoverageScore(%d) = %v, issues %v", year, cov.Score, cov.Issues)
+		}
+	}
+
+	cov, _ := checker.CoverageScore(2026)
+	if cov.Days["春节"] != 9 || cov.Days["国庆节"] != 7 {
+		t.Errorf("Days = %v", cov.Days)
+	}
+}
+
+func TestCoverageScoreTruncated(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	// 只有上半年的数据，且春节少于法定天数
+	err := checker.LoadYearFromJSON(2099, []byte(`{
+		"holidays": {
+			"2099-01-01": "New Year's Day,元旦,1",
+			"2099-01-21": "Spring Festival,春节,4",
+			"2099-01-22": "Spring Festival,春节,4",
+			"2099-05-01": "Labour Day,劳动节,2",
+			"2099-05-02": "Labour Day,劳动节,2"
+		},
+		"workdays": {},
+		"inLieuDays": {}
+	}`))
+	if err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+
+	cov, err := checker.CoverageScore(2099)
+	if err != nil {
+		t.Fatalf("CoverageScore failed: %v", err)
+	}
+	if cov.Complete() || cov.Score <= 0 || cov.Score >= 0.8 {
+		t.Errorf("Score = %v, issues %v", cov.Score, cov.Issues)
+	}
+	if want := []string{"清明", "端午", "中秋", "国庆节"}; !reflect.DeepEqual(cov.Missing, want) {
+		t.Errorf("Missing = %v, want %v", cov.Missing, want)
+	}
+
+	var paths []string
+	for _, issue := range cov.Issues {
+		paths = append(paths, issue.Path)
+	}
+	want := []string{"holidays.春节", "holidays.清明", "holidays.端午", "holidays.中秋", "holidays.国庆节", "holidays"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("issue paths = %v, want %v", paths, want)
+	}
+}
+
+func TestCoverageScoreMergedMidAutumn(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	data := &HolidayData{
+		Holidays: map[string]string{
+			"2098-01-01": "New Year's Day,元旦,1",
+			"2098-04-04": "Tomb-sweeping Day,清明,1",
+			"2098-05-01": "Labour Day,劳动节,1",
+			"2098-06-01": "Dragon Boat Festival,端午,1",
+		},
+		Workdays:   map[string]string{},
+		InLieuDays: map[string]string{},
+	}
+	for day := 1; day <= 4; day++ {
+		data.Holidays[fmt.Sprintf("2098-02-%02d", day)] = "Spring Festival,春节,3"
+	}
+	// 中秋并入国庆，数据中只有国庆节
+	for day := 1; day <= 8; day++ {
+		data.Holidays[fmt.Sprintf("2098-10-%02d", day)] = "National Day,国庆节,3"
+	}
+	jsonData, _ := json.Marshal(data)
+	if err := checker.LoadYearFromJSON(2098, jsonData); err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+
+	cov, err := checker.CoverageScore(2098)
+	if err != nil {
+		t.Fatalf("CoverageScore failed: %v", err)
+	}
+	if len(cov.Missing) != 0 {
+		t.Errorf("Missing = %v, issues %v", cov.Missing, cov.Issues)
+	}
+}
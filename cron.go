@@ -0,0 +1,200 @@
+package cnholiday
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RunPolicy 定时任务按日期类型过滤的策略
+type RunPolicy uint8
+
+const (
+	RunAnyDay         RunPolicy = iota // 不过滤
+	RunWorkdays                        // 仅工作日(含调休工作日)
+	RunRestDays                        // 仅休息日(周末与节假日)
+	RunPublicHolidays                  // 仅节假日(含补休日)，不含普通周末
+)
+
+// maxCronSearchDays NextCronRun 向后查找的最大天数
+const maxCronSearchDays = 366 * 5
+
+// cronSchedule 解析后的 cron 表达式，每个字段为允许取值的位图
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+// cronFields 标准 cron 五个字段的取值范围
+var cronFields = [5]struct {
+	name     string
+	min, max int
+}{
+	{"分钟", 0, 59},
+	{"小时", 0, 23},
+	{"日", 1, 31},
+	{"月", 1, 12},
+	{"星期", 0, 7},
+}
+
+// NextCronRun 返回 from 之后(不含)第一个满足 cron 表达式且日期类型符合 policy 的时间
+// spec 为标准五字段 cron 表达式(分 时 日 月 星期)，支持 *、列表、范围与步长，星期 0 和 7 均表示周日
+// 日与星期同时限定时，满足其一即可
+func (c *Checker) NextCronRun(spec string, from time.Time, policy RunPolicy) (time.Time, error) {
+	sched, err := parseCron(spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	start := from.Truncate(time.Minute).Add(time.Minute)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	for i := 0; i < maxCronSearchDays; i++ {
+		if sched.matchesDay(day) {
+			ok, err := c.matchesPolicy(day, policy)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if ok {
+				if t, found := sched.firstTimeOn(day, start); found {
+					return t, nil
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}, fmt.Errorf("%d 天内没有满足条件的执行时间: %s", maxCronSearchDays, spec)
+}
+
+// matchesPolicy 判断日期类型是否满足 policy
+func (c *Checker) matchesPolicy(date time.Time, policy RunPolicy) (bool, error) {
+	if policy == RunAnyDay {
+		return true, nil
+	}
+	dayType, _, err := c.Classify(date)
+	if err != nil {
+		return false, err
+	}
+	switch policy {
+	case RunWorkdays:
+		return dayType.IsWorkday(), nil
+	case RunRestDays:
+		return !dayType.IsWorkday(), nil
+	case RunPublicHolidays:
+		return dayType == DayTypePublicHoliday || dayType == DayTypeInLieu, nil
+	default:
+		return false, fmt.Errorf("未知的执行策略: %d", policy)
+	}
+}
+
+// parseCron 解析标准五字段 cron 表达式
+func parseCron(spec string) (*cronSchedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("cron 表达式必须包含 5 个字段: %q", spec)
+	}
+
+	var bits [5]uint64
+	for i, part := range parts {
+		b, err := parseCronField(part, cronFields[i].min, cronFields[i].max)
+		if err != nil {
+			return nil, fmt.Errorf("cron %s字段无效: %w", cronFields[i].name, err)
+		}
+		bits[i] = b
+	}
+	// 星期 7 等同于周日
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return &cronSchedule{
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		domAny: parts[2] == "*",
+		dowAny: parts[4] == "*",
+	}, nil
+}
+
+// parseCronField 解析单个字段，返回允许取值的位图
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepStr)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("步长无效: %q", item)
+			}
+			step = n
+		}
+
+		lo, hi := min, max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = strconv.Atoi(loStr); err != nil {
+				return 0, fmt.Errorf("取值无效: %q", item)
+			}
+			hi = lo
+			if isRange {
+				if hi, err = strconv.Atoi(hiStr); err != nil {
+					return 0, fmt.Errorf("取值无效: %q", item)
+				}
+			} else if hasStep {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("取值超出范围 %d-%d: %q", min, max, item)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	if bits == 0 {
+		return 0, errors.New("字段为空")
+	}
+	return bits, nil
+}
+
+// matchesDay 判断日期是否满足日、月、星期字段
+func (s *cronSchedule) matchesDay(day time.Time) bool {
+	if s.month&(1<<uint(day.Month())) == 0 {
+		return false
+	}
+	domMatch := s.dom&(1<<uint(day.Day())) != 0
+	dowMatch := s.dow&(1<<uint(day.Weekday())) != 0
+	switch {
+	case s.domAny && s.dowAny:
+		return true
+	case s.domAny:
+		return dowMatch
+	case s.dowAny:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// firstTimeOn 返回当天不早于 notBefore 的第一个满足时、分字段的时间
+func (s *cronSchedule) firstTimeOn(day, notBefore time.Time) (time.Time, bool) {
+	for h := 0; h < 24; h++ {
+		if s.hour&(1<<h) == 0 {
+			continue
+		}
+		for m := 0; m < 60; m++ {
+			if s.minute&(1<<m) == 0 {
+				continue
+			}
+			t := time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, day.Location())
+			if !t.Before(notBefore) {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
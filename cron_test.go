@@ -0,0 +1,43 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextCronRun(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	from := time.Date(2026, 9, 30, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		spec   string
+		policy RunPolicy
+		want   string
+	}{
+		{"0 9 * * *", RunAnyDay, "2026-10-01 09:00"},
+		{"0 9 * * *", RunWorkdays, "2026-10-08 09:00"},
+		{"30 10 * * 1-5", RunWorkdays, "2026-09-30 10:30"},
+		{"0 9 * * 6", RunWorkdays, "2026-10-10 09:00"},
+		{"0 */6 * * *", RunRestDays, "2026-10-01 00:00"},
+		{"0 9 * * 0,6", RunPublicHolidays, "2026-10-03 09:00"},
+		{"0 0 1 * *", RunWorkdays, "2026-12-01 00:00"},
+	}
+	for _, tt := range tests {
+		got, err := checker.NextCronRun(tt.spec, from, tt.policy)
+		if err != nil {
+			t.Fatalf("NextCronRun(%q, %d) failed: %v", tt.spec, tt.policy, err)
+		}
+		if g := got.Format("2006-01-02 15:04"); g != tt.want {
+			t.Errorf("NextCronRun(%q, %d) = %s, want %s", tt.spec, tt.policy, g, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "a * * * *", "5-1 * * * *"} {
+		if _, err := checker.NextCronRun(bad, from, RunAnyDay); err == nil {
+			t.Errorf("NextCronRun(%q) should fail", bad)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package cnholiday
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// 春节、元旦的调休工作日可能落在相邻的自然年，并只出现在发布通知那一年的数据文件中，
+// 例如次年元旦的调休安排在本年 12 月的周末。Checker 记录每个年份数据中属于相邻年份的调休工作日，
+// 构建相邻年份的索引时合并进去，使跨年的区间统计、AddWorkdays 等计算与两个年份的加载顺序无关
+
+// crossYearWorkdays 返回 data 中属于 year 前后相邻年份的调休工作日，按目标年份分组
+func crossYearWorkdays(year int, data *yearData) map[int]map[string]string {
+	spill := make(map[int]map[string]string)
+	for date, name := range data.workdays {
+		if !isAdjacentYear(year, date) {
+			continue
+		}
+		target, _ := strconv.Atoi(date[:4])
+		if spill[target] == nil {
+			spill[target] = make(map[string]string)
+		}
+		spill[target][date] = name
+	}
+	return spill
+}
+
+// isAdjacentYear 判断 YYYY-MM-DD 格式的日期是否属于 year 的前一年或后一年
+func isAdjacentYear(year int, date string) bool {
+	if len(date) != 10 || date[4] != '-' {
+		return false
+	}
+	y, err := strconv.Atoi(date[:4])
+	return err == nil && (y == year-1 || y == year+1)
+}
+
+// crossYearAmendment 相邻年份的调休工作日变化后，为该年份追加的变更记录
+type crossYearAmendment struct {
+	year      int
+	amendment Amendment
+}
+
+// recordCrossYear 记录 year 年数据中属于相邻年份的调休工作日，并重建受影响且已缓存的相邻年份索引。
+// 重建的索引以 source 为来源追加一条变更记录，使 History、AsOf 与实时查询一致，返回的记录由调用方在释放锁后通知；
+// 重建不改变相邻年份索引的数据来源。调用方需持有写锁
+func (c *Checker) recordCrossYear(year int, data *yearData, source Source, at time.Time) []crossYearAmendment {
+	var amendments []crossYearAmendment
+	spill := crossYearWorkdays(year, data)
+	for _, target := range []int{year - 1, year + 1} {
+		entries := spill[target]
+		if maps.Equal(entries, c.crossYear[target][year]) {
+			continue
+		}
+
+		if len(entries) == 0 {
+			delete(c.crossYear[target], year)
+		} else {
+			if c.crossYear[target] == nil {
+				c.crossYear[target] = make(map[int]map[string]string)
+			}
+			c.crossYear[target][year] = entries
+		}
+
+		if cached := c.cache[target]; cached != nil {
+			rebuilt := c.buildIndex(target, cached.base)
+			amendment := c.appendHistory(target, source, rebuilt, at)
+			rebuilt.source = cached.source
+			c.cache[target] = rebuilt
+			amendments = append(amendments, crossYearAmendment{year: target, amendment: amendment})
+		}
+	}
+	return amendments
+}
+
+// buildIndex 合并相邻年份数据中属于 year 年的调休工作日后构建索引
+// 本年份数据中已有记录的日期以本年份数据为准。调用方需持有锁
+func (c *Checker) buildIndex(year int, data *yearData) *yearIndex {
+	spill := c.crossYear[year]
+	if len(spill) == 0 {
+		idx := newYearIndex(year, data.view())
+		idx.base = data
+		return idx
+	}
+
+	merged := &HolidayData{
+		Holidays:   data.holidays,
+		Workdays:   maps.Clone(data.workdays),
+		InLieuDays: data.inLieuDays,
+	}
+	for _, source := range slices.Sorted(maps.Keys(spill)) {
+		for date, name := range spill[source] {
+			if _, exists := merged.Workdays[date]; exists {
+				continue
+			}
+			if _, exists := data.holidays[date]; exists {
+				continue
+			}
+			merged.Workdays[date] = name
+		}
+	}
+
+	idx := newYearIndex(year, merged)
+	idx.base = data
+	return idx
+}
@@ -0,0 +1,120 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+// 虚构的 2027 年数据，元旦调休工作日安排在 2026 年 12 月 27 日(周日)
+const crossYear2027JSON = `{
+	"holidays": {"2027-01-01": "元旦", "2027-01-02": "元旦", "2027-01-03": "元旦", "2027-01-04": "元旦"},
+	"workdays": {"2026-12-27": "元旦"}
+}`
+
+func TestCrossYearWorkdays(t *testing.T) {
+	sunday := time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC)
+
+	for _, order := range [][]int{{2026, 2027}, {2027, 2026}} {
+		checker := NewCheckerWithConfig(Config{DisableRemote: true, StrictValidation: true})
+		for _, year := range order {
+			var err error
+			if year == 2027 {
+				err = checker.LoadYearFromJSON(2027, []byte(crossYear2027JSON))
+			} else {
+				err = checker.LoadYear(2026)
+			}
+			if err != nil {
+				t.Fatalf("load %d failed: %v", year, err)
+			}
+		}
+
+		dayType, name, err := checker.Classify(sunday)
+		if err != nil || dayType != DayTypeAdjustedWorkday || name != "元旦" {
+			t.Errorf("order %v: Classify(2026-12-27) = %s, %q, %v; want adjusted_workday 元旦", order, dayType, name, err)
+		}
+
+		// 2026-12-21 ~ 2027-01-08：12 月 21 日起三周共 15 个工作日，加上 12-27 调休，减去元旦 1 月 1 日、4 日
+		count, err := checker.CountWorkdaysBetween(time.Date(2026, 12, 21, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 8, 0, 0, 0, 0, time.UTC))
+		if err != nil || count != 14 {
+			t.Errorf("order %v: CountWorkdaysBetween() = %d, %v; want 14", order, count, err)
+		}
+
+		next, err := checker.AddWorkdays(time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), 1)
+		if err != nil || !next.Equal(sunday) {
+			t.Errorf("order %v: AddWorkdays(2026-12-25, 1) = %v, %v; want 2026-12-27", order, next, err)
+		}
+
+		if isHoliday, _ := checker.IsHolidayFast(sunday); isHoliday {
+			t.Errorf("order %v: IsHolidayFast(2026-12-27) should be false", order)
+		}
+	}
+}
+
+func TestCrossYearPatch(t *testing.T) {
+	sunday := time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC)
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if err := checker.LoadYearFromJSON(2027, []byte(`{"holidays": {"2027-01-01": "元旦"}}`)); err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+
+	// 修订 2027 年数据时加入落在 2026 年的调休工作日，与正常加载一样合并到 2026 年
+	if _, err := checker.PatchYear(2027, &HolidayData{Workdays: map[string]string{"2026-12-27": "元旦"}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	if dayType, name, err := checker.Classify(sunday); err != nil || dayType != DayTypeAdjustedWorkday || name != "元旦" {
+		t.Errorf("Classify(2026-12-27) = %s, %q, %v; want adjusted_workday 元旦", dayType, name, err)
+	}
+	if next, err := checker.NextWorkday(time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC)); err != nil || !next.Equal(sunday) {
+		t.Errorf("NextWorkday(2026-12-25) = %v, %v; want 2026-12-27", next, err)
+	}
+
+	// 删除后恢复为周末
+	if _, err := checker.PatchYear(2027, &HolidayData{Workdays: map[string]string{"2026-12-27": ""}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	if dayType, _, _ := checker.Classify(sunday); dayType != DayTypeWeekend {
+		t.Errorf("Classify(2026-12-27) = %s, want weekend", dayType)
+	}
+
+	if _, err := checker.PatchYear(2027, &HolidayData{Holidays: map[string]string{"2026-12-31": "元旦"}}); err == nil {
+		t.Error("holidays in the adjacent year should still be rejected")
+	}
+}
+
+func TestCrossYearOwnDataWins(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYearFromJSON(2026, []byte(`{"holidays":{"2026-12-27":"年末假期"},"workdays":{}}`)); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+	if err := checker.LoadYearFromJSON(2027, []byte(crossYear2027JSON)); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	if dayType, name, _ := checker.Classify(time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC)); dayType != DayTypePublicHoliday || name != "年末假期" {
+		t.Errorf("Classify(2026-12-27) = %s, %q; 2026 data should take precedence", dayType, name)
+	}
+
+	// 2027 年数据更新后撤销跨年调休，2026 年随之更新；本年数据的修订保留合并结果
+	if err := checker.LoadYearFromJSON(2026, []byte(`{"holidays":{},"workdays":{}}`)); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if _, err := checker.PatchYear(2026, &HolidayData{Holidays: map[string]string{"2026-12-31": "跨年"}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	if dayType, _, _ := checker.Classify(time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC)); dayType != DayTypeAdjustedWorkday {
+		t.Errorf("Classify(2026-12-27) = %s after patch, want adjusted_workday", dayType)
+	}
+
+	if err := checker.LoadYearFromJSON(2027, []byte(`{"holidays":{},"workdays":{}}`)); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if dayType, _, _ := checker.Classify(time.Date(2026, 12, 27, 0, 0, 0, 0, time.UTC)); dayType != DayTypeWeekend {
+		t.Errorf("Classify(2026-12-27) = %s, want weekend after 2027 data drops it", dayType)
+	}
+	if info, _ := checker.GetHolidayInfo(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)); info.HolidayName != "跨年" {
+		t.Errorf("patch lost after cross-year rebuild: %+v", info)
+	}
+}
@@ -0,0 +1,181 @@
+package cnholiday
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// ErrInvalidRange 区间的结束日期早于开始日期
+var ErrInvalidRange = errors.New("结束日期早于开始日期")
+
+// Bounds 区间两端是否包含在区间内，零值 BoundsClosed 表示两端都包含
+// 不同团队对"从 A 到 B"是否包含 B 理解不一致时，计薪等场景会差一天，调用方应显式选择边界
+type Bounds uint8
+
+const (
+	BoundsClosed     Bounds = iota // [Start, End]，两端都包含，默认
+	BoundsClosedOpen               // [Start, End)，包含开始日期，不包含结束日期，例如按"入职日至离职日"计算时离职日不计
+	BoundsOpenClosed               // (Start, End]，不包含开始日期，包含结束日期
+	BoundsOpen                     // (Start, End)，两端都不包含
+)
+
+// boundsNotations 边界的区间记号，与 Bounds 的取值一一对应
+var boundsNotations = [...]string{"[]", "[)", "(]", "()"}
+
+// String 返回区间记号，例如 "[)"
+func (b Bounds) String() string {
+	if int(b) < len(boundsNotations) {
+		return boundsNotations[b]
+	}
+	return fmt.Sprintf("Bounds(%d)", b)
+}
+
+// ParseBounds 解析区间记号 "[]"、"[)"、"(]"、"()"，用于从命令行参数或配置文件读取边界
+func ParseBounds(s string) (Bounds, error) {
+	for i, notation := range boundsNotations {
+		if s == notation {
+			return Bounds(i), nil
+		}
+	}
+	return 0, fmt.Errorf("无效的区间边界: %q，可选 [] [) (] ()", s)
+}
+
+// valid 判断是否是已定义的边界
+func (b Bounds) valid() bool {
+	return int(b) < len(boundsNotations)
+}
+
+// DateRange 以天为单位的区间，时刻部分被忽略，按 Start 的时区逐日计算
+// Bounds 为零值时是闭区间 [Start, End]，例如 DateRange{10月1日, 10月7日} 共 7 天；
+// 统计、列表、切分和导出等接受 DateRange 的方法按 Bounds 处理两端，含义一致。
+// 列表和切分方法返回的日期是 Start 时区中当天的零点，不保留 Start 的时刻；
+// NextWorkday、AddWorkdays 等从单个日期出发的方法则保留传入日期的时刻和时区
+type DateRange struct {
+	Start  time.Time
+	End    time.Time
+	Bounds Bounds
+}
+
+// NewDateRange 创建区间并校验，结束日期早于开始日期时返回 ErrInvalidRange
+func NewDateRange(start, end time.Time) (DateRange, error) {
+	r := DateRange{Start: start, End: end}
+	if err := r.Validate(); err != nil {
+		return DateRange{}, err
+	}
+	return r, nil
+}
+
+// YearRange 返回 year 年 1 月 1 日至 12 月 31 日的区间，时区为 time.Local
+func YearRange(year int) DateRange {
+	return DateRange{
+		Start: time.Date(year, 1, 1, 0, 0, 0, 0, time.Local),
+		End:   time.Date(year, 12, 31, 0, 0, 0, 0, time.Local),
+	}
+}
+
+// WithBounds 返回边界为 b 的同一区间
+func (r DateRange) WithBounds(b Bounds) DateRange {
+	r.Bounds = b
+	return r
+}
+
+// Closed 返回包含相同日期的闭区间，不包含任何日期时(例如 [10月1日, 10月1日))结束日期早于开始日期
+func (r DateRange) Closed() DateRange {
+	return DateRange{Start: r.first(), End: r.last()}
+}
+
+// first、last 按边界返回区间实际包含的第一天和最后一天的零点，最后一天换算到 Start 的时区
+// 区间不包含任何日期时 last 早于 first
+func (r DateRange) first() time.Time {
+	day := truncateDay(r.Start)
+	if r.Bounds == BoundsOpenClosed || r.Bounds == BoundsOpen {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+func (r DateRange) last() time.Time {
+	y, m, d := r.End.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, r.Start.Location())
+	if r.Bounds == BoundsClosedOpen || r.Bounds == BoundsOpen {
+		day = day.AddDate(0, 0, -1)
+	}
+	return day
+}
+
+// Validate 校验区间，结束日期早于开始日期时返回 ErrInvalidRange
+// 开区间可以不包含任何日期，例如 [10月1日, 10月1日)，这不是错误，统计结果为 0
+func (r DateRange) Validate() error {
+	if !r.Bounds.valid() {
+		return fmt.Errorf("无效的区间边界: %d", r.Bounds)
+	}
+	y, m, d := r.End.Date()
+	if time.Date(y, m, d, 0, 0, 0, 0, r.Start.Location()).Before(truncateDay(r.Start)) {
+		return ErrInvalidRange
+	}
+	return nil
+}
+
+// empty 判断有效区间是否不包含任何日期
+func (r DateRange) empty() bool {
+	return r.last().Before(r.first())
+}
+
+// Days 返回区间包含的天数，区间无效时返回 0
+func (r DateRange) Days() int {
+	if r.Validate() != nil || r.empty() {
+		return 0
+	}
+	return daysBetween(r.first(), r.last()) + 1
+}
+
+// Contains 判断日期是否在区间内，只比较日期部分
+func (r DateRange) Contains(date time.Time) bool {
+	y, m, d := date.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, r.Start.Location())
+	return !day.Before(r.first()) && !day.After(r.last())
+}
+
+// Years 返回区间包含的日期涉及的年份，按升序排列
+func (r DateRange) Years() []int {
+	if r.Validate() != nil || r.empty() {
+		return nil
+	}
+	years := make([]int, 0, r.last().Year()-r.first().Year()+1)
+	for year := r.first().Year(); year <= r.last().Year(); year++ {
+		years = append(years, year)
+	}
+	return years
+}
+
+// All 按日期升序遍历区间内的每一天(零点)，区间无效时不产生任何日期
+//
+//	for day := range r.All() {
+//		...
+//	}
+func (r DateRange) All() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if r.Validate() != nil {
+			return
+		}
+		last := r.last()
+		for day := r.first(); !day.After(last); day = day.AddDate(0, 0, 1) {
+			if !yield(day) {
+				return
+			}
+		}
+	}
+}
+
+// String 闭区间格式化为 "2026-10-01 ~ 2026-10-07"，其他边界在两端加上区间记号，例如 "[2026-10-01 ~ 2026-10-08)"
+func (r DateRange) String() string {
+	y, m, d := r.End.Date()
+	s := fmt.Sprintf("%s ~ %04d-%02d-%02d", r.Start.Format("2006-01-02"), y, m, d)
+	if r.Bounds == BoundsClosed || !r.Bounds.valid() {
+		return s
+	}
+	notation := r.Bounds.String()
+	return notation[:1] + s + notation[1:]
+}
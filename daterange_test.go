@@ -0,0 +1,230 @@
+package cnholiday
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDateRange(t *testing.T) {
+	start := time.Date(2025, 12, 30, 18, 0, 0, 0, time.Local)
+	end := time.Date(2026, 1, 2, 9, 0, 0, 0, time.Local)
+	r, err := NewDateRange(start, end)
+	if err != nil {
+		t.Fatalf("NewDateRange failed: %v", err)
+	}
+
+	if r.Days() != 4 {
+		t.Errorf("Days = %d, want 4", r.Days())
+	}
+	if got := r.String(); got != "2025-12-30 ~ 2026-01-02" {
+		t.Errorf("String = %q", got)
+	}
+	if !reflect.DeepEqual(r.Years(), []int{2025, 2026}) {
+		t.Errorf("Years = %v", r.Years())
+	}
+	// 只比较日期部分
+	if !r.Contains(time.Date(2026, 1, 2, 23, 59, 0, 0, time.Local)) || r.Contains(time.Date(2026, 1, 3, 0, 0, 0, 0, time.Local)) {
+		t.Error("Contains should compare dates only")
+	}
+
+	var days []string
+	for day := range r.All() {
+		days = append(days, day.Format("2006-01-02 15:04"))
+		if len(days) == 3 {
+			break
+		}
+	}
+	if want := []string{"2025-12-30 00:00", "2025-12-31 00:00", "2026-01-01 00:00"}; !reflect.DeepEqual(days, want) {
+		t.Errorf("All = %v, want %v", days, want)
+	}
+
+	// 同一天内结束时刻早于开始时刻仍是有效的一天
+	sameDay := DateRange{Start: start, End: start.Add(-8 * time.Hour)}
+	if err := sameDay.Validate(); err != nil || sameDay.Days() != 1 {
+		t.Errorf("same-day range: err = %v, days = %d", err, sameDay.Days())
+	}
+
+	if _, err := NewDateRange(end, start); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("NewDateRange(reversed) error = %v, want ErrInvalidRange", err)
+	}
+	reversed := DateRange{Start: end, End: start}
+	if reversed.Days() != 0 || reversed.Years() != nil {
+		t.Errorf("reversed range: days = %d, years = %v", reversed.Days(), reversed.Years())
+	}
+	for range reversed.All() {
+		t.Fatal("All should yield nothing for a reversed range")
+	}
+
+	if got := YearRange(2024).Days(); got != 366 {
+		t.Errorf("YearRange(2024).Days = %d, want 366", got)
+	}
+}
+
+func TestDateRangeMethods(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	national := DateRange{
+		Start: time.Date(2026, 9, 28, 15, 0, 0, 0, time.Local),
+		End:   time.Date(2026, 10, 12, 8, 0, 0, 0, time.Local),
+	}
+
+	// 两端都包含，与时刻无关
+	n, err := checker.CountWorkdaysIn(national)
+	if err != nil {
+		t.Fatalf("CountWorkdaysIn failed: %v", err)
+	}
+	if n != 7 { // 9/28-9/30、10/8、10/9、10/10(调休)、10/12
+		t.Errorf("CountWorkdaysIn = %d, want 7", n)
+	}
+	if m, _ := checker.CountWorkdaysBetween(national.Start, national.End); m != n {
+		t.Errorf("CountWorkdaysBetween = %d, want %d", m, n)
+	}
+
+	holidays, err := checker.ListHolidaysIn(national)
+	if err != nil {
+		t.Fatalf("ListHolidaysIn failed: %v", err)
+	}
+	if len(holidays) != 7 || holidays[0].Date.Format(time.DateOnly) != "2026-10-01" || holidays[6].Date.Format(time.DateOnly) != "2026-10-07" {
+		t.Errorf("ListHolidaysIn = %+v", holidays)
+	}
+
+	// 跨年区间逐年加载
+	newYear, err := checker.ListHolidaysIn(DateRange{Start: time.Date(2025, 12, 31, 0, 0, 0, 0, time.Local), End: time.Date(2026, 1, 1, 0, 0, 0, 0, time.Local)})
+	if err != nil || len(newYear) != 1 || newYear[0].Date.Format(time.DateOnly) != "2026-01-01" {
+		t.Errorf("ListHolidaysIn(new year) = %+v, %v", newYear, err)
+	}
+
+	breaks, err := checker.HolidayBreaks(2026)
+	if err != nil {
+		t.Fatalf("HolidayBreaks failed: %v", err)
+	}
+	for _, hb := range breaks {
+		runs, err := checker.SplitByDayTypeIn(hb.Range())
+		if err != nil {
+			t.Fatalf("SplitByDayTypeIn failed: %v", err)
+		}
+		if len(runs) == 0 || runs[0].Range().Days() > hb.Range().Days() {
+			t.Errorf("SplitByDayTypeIn(%s) = %+v", hb.Range(), runs)
+		}
+	}
+
+	reversed := DateRange{Start: national.End, End: national.Start}
+	for name, fn := range map[string]func() error{
+		"CountWorkdaysIn":  func() error { _, err := checker.CountWorkdaysIn(reversed); return err },
+		"ListHolidaysIn":   func() error { _, err := checker.ListHolidaysIn(reversed); return err },
+		"SplitByDayTypeIn": func() error { _, err := checker.SplitByDayTypeIn(reversed); return err },
+		"WriteICSIn":       func() error { return checker.WriteICSIn(&strings.Builder{}, reversed) },
+	} {
+		if err := fn(); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("%s(reversed) error = %v, want ErrInvalidRange", name, err)
+		}
+	}
+}
+
+func TestDateRangeBounds(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	start := time.Date(2026, 9, 30, 0, 0, 0, 0, time.Local) // 周三，工作日
+	end := time.Date(2026, 10, 9, 0, 0, 0, 0, time.Local)   // 周五，工作日
+
+	tests := []struct {
+		bounds   Bounds
+		days     int
+		workdays int
+		first    string
+		last     string
+		str      string
+	}{
+		{BoundsClosed, 10, 3, "2026-09-30", "2026-10-09", "2026-09-30 ~ 2026-10-09"},
+		{BoundsClosedOpen, 9, 2, "2026-09-30", "2026-10-08", "[2026-09-30 ~ 2026-10-09)"},
+		{BoundsOpenClosed, 9, 2, "2026-10-01", "2026-10-09", "(2026-09-30 ~ 2026-10-09]"},
+		{BoundsOpen, 8, 1, "2026-10-01", "2026-10-08", "(2026-09-30 ~ 2026-10-09)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.bounds.String(), func(t *testing.T) {
+			r := DateRange{Start: start, End: end, Bounds: tt.bounds}
+			if r.Days() != tt.days {
+				t.Errorf("Days = %d, want %d", r.Days(), tt.days)
+			}
+			if got := r.String(); got != tt.str {
+				t.Errorf("String = %q, want %q", got, tt.str)
+			}
+			closed := r.Closed()
+			if closed.Start.Format(time.DateOnly) != tt.first || closed.End.Format(time.DateOnly) != tt.last || closed.Bounds != BoundsClosed {
+				t.Errorf("Closed = %s, want %s ~ %s", closed, tt.first, tt.last)
+			}
+			if r.Contains(start) != (tt.first == "2026-09-30") || r.Contains(end) != (tt.last == "2026-10-09") {
+				t.Error("Contains disagrees with the bounds")
+			}
+			if n, err := checker.CountWorkdaysIn(r); err != nil || n != tt.workdays {
+				t.Errorf("CountWorkdaysIn = %d, %v, want %d", n, err, tt.workdays)
+			}
+
+			closedAttendance, err := checker.ExpectedAttendance(closed.Start, closed.End, AttendancePolicy{})
+			if err != nil || closedAttendance.TotalDays != tt.days {
+				t.Errorf("ExpectedAttendance(Closed) = %+v, %v, want %d days", closedAttendance, err, tt.days)
+			}
+
+			parsed, err := ParseBounds(tt.bounds.String())
+			if err != nil || parsed != tt.bounds {
+				t.Errorf("ParseBounds(%q) = %v, %v", tt.bounds.String(), parsed, err)
+			}
+		})
+	}
+
+	// 不包含任何日期的区间不是错误
+	empty := DateRange{Start: start, End: start, Bounds: BoundsClosedOpen}
+	if err := empty.Validate(); err != nil || empty.Days() != 0 {
+		t.Errorf("empty range: err = %v, days = %d", err, empty.Days())
+	}
+	if n, err := checker.CountWorkdaysIn(empty); err != nil || n != 0 {
+		t.Errorf("CountWorkdaysIn(empty) = %d, %v", n, err)
+	}
+	if _, err := checker.CountWorkdaysIn(DateRange{Start: end, End: start, Bounds: BoundsOpen}); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("reversed open range error = %v, want ErrInvalidRange", err)
+	}
+
+	if _, err := ParseBounds("[["); err == nil {
+		t.Error("expected error for invalid notation")
+	}
+	if err := (DateRange{Start: start, End: end, Bounds: 9}).Validate(); err == nil {
+		t.Error("expected error for undefined bounds")
+	}
+
+	// start、end 参数形式的方法两端都包含，检查器与各种包装得到相同的结果
+	from, to := time.Date(2026, 10, 9, 0, 0, 0, 0, time.Local), time.Date(2026, 10, 16, 0, 0, 0, 0, time.Local)
+	want, err := checker.CountWorkdaysBetween(from, to)
+	if err != nil || want != 7 {
+		t.Fatalf("CountWorkdaysBetween = %d, %v, want 7", want, err)
+	}
+	if n, err := WithMemo(checker, 0).CountWorkdaysBetween(from, to); err != nil || n != want {
+		t.Errorf("MemoChecker.CountWorkdaysBetween = %d, %v, want %d", n, err, want)
+	}
+	if n, err := CountWorkdays(checker.IsWorkday, from, to); err != nil || n != want {
+		t.Errorf("CountWorkdays = %d, %v, want %d", n, err, want)
+	}
+}
+
+func TestDateRangeLocation(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	zone := time.FixedZone("UTC+8", 8*3600)
+	start := time.Date(2026, 9, 30, 15, 30, 0, 0, zone)
+
+	// 列表方法返回 Start 时区中的零点
+	holidays, err := checker.ListHolidaysIn(DateRange{Start: start, End: start.AddDate(0, 0, 3)})
+	if err != nil || len(holidays) == 0 {
+		t.Fatalf("ListHolidaysIn = %v, %v", holidays, err)
+	}
+	for _, h := range holidays {
+		if h.Date.Location() != zone || h.Date.Hour() != 0 || h.Date.Minute() != 0 {
+			t.Errorf("ListHolidaysIn date = %v, want midnight in %s", h.Date, zone)
+		}
+	}
+
+	// 从单个日期出发的方法保留时刻和时区
+	next, err := checker.NextHoliday(start)
+	if err != nil || next.Date.Location() != zone || next.Date.Hour() != 15 || next.Date.Format(time.DateOnly) != "2026-10-01" {
+		t.Errorf("NextHoliday = %v, %v, want 2026-10-01 15:30 in %s", next, err, zone)
+	}
+}
@@ -0,0 +1,71 @@
+package cnholiday
+
+import (
+	"errors"
+	"time"
+)
+
+// DayContextInfo 一天的日期信息及其上下文，即日历中一个日期格子展示所需的全部数据
+type DayContextInfo struct {
+	Info          HolidayInfo   // 当天的日期信息，与 GetHolidayInfo 相同
+	Break         *HolidayBreak // 当天所在的连续放假，不在放假期间(含普通周末)时为 nil
+	DaysRemaining int           // 所在放假剩余的天数(含当天)，最后一天为 1，不在放假期间时为 0
+	NextWorkday   time.Time     // 之后(不含当天)的第一个工作日，相邻年份缺少数据无法确定时为零值
+	PrevWorkday   time.Time     // 之前(不含当天)的最后一个工作日，相邻年份缺少数据无法确定时为零值
+}
+
+// DayContext 一次返回 date 的日期类型、所在的连续放假、假期剩余天数以及前后的工作日，
+// 代替分别调用 GetHolidayInfo、HolidayBreaks、NextWorkday、PrevWorkday。
+// 所在的连续放假跨年时不按自然年截断，与 HolidayBreaks 不同；当天的数据加载失败时返回错误
+func (c *Checker) DayContext(date time.Time) (*DayContextInfo, error) {
+	dc := &DayContextInfo{}
+	if err := c.GetHolidayInfoInto(date, &dc.Info); err != nil {
+		return nil, err
+	}
+
+	if dayType := dc.Info.DayType(); dayType == DayTypePublicHoliday || dayType == DayTypeInLieu {
+		hb, err := c.breakAround(truncateDay(date))
+		if err != nil {
+			return nil, err
+		}
+		dc.Break = hb
+		dc.DaysRemaining = daysBetween(date, hb.End) + 1
+	}
+
+	var err error
+	if dc.NextWorkday, err = c.NextWorkday(date); err != nil && !isYearLoadError(err) {
+		return nil, err
+	}
+	if dc.PrevWorkday, err = c.PrevWorkday(date); err != nil && !isYearLoadError(err) {
+		return nil, err
+	}
+	return dc, nil
+}
+
+// breakAround 返回放假日 day 所在的连续放假，向前后逐日扩展到第一个非放假日，
+// 相邻年份的数据加载失败时止于有数据的一侧
+func (c *Checker) breakAround(day time.Time) (*HolidayBreak, error) {
+	isOff := func(day time.Time) bool {
+		dayType, _, _, err := c.classify(day)
+		return err == nil && (dayType == DayTypePublicHoliday || dayType == DayTypeInLieu)
+	}
+	start, end := day, day
+	for isOff(start.AddDate(0, 0, -1)) {
+		start = start.AddDate(0, 0, -1)
+	}
+	for isOff(end.AddDate(0, 0, 1)) {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	breaks, err := c.holidayBreaks(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &breaks[0], nil
+}
+
+// isYearLoadError 判断 err 是否为年份数据加载失败
+func isYearLoadError(err error) bool {
+	var loadErr *YearLoadError
+	return errors.As(err, &loadErr)
+}
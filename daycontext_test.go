@@ -0,0 +1,77 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDayContext(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	date := func(s string) time.Time {
+		d, _ := time.ParseInLocation(time.DateOnly, s, time.Local)
+		return d
+	}
+	format := func(d time.Time) string {
+		if d.IsZero() {
+			return ""
+		}
+		return d.Format(time.DateOnly)
+	}
+
+	tests := []struct {
+		date       string
+		dayType    DayType
+		breakStart string // 为空表示不在放假期间
+		breakEnd   string
+		remaining  int
+		next       string
+		prev       string
+	}{
+		{"2026-10-03", DayTypePublicHoliday, "2026-10-01", "2026-10-07", 5, "2026-10-08", "2026-09-30"},
+		{"2026-10-07", DayTypeInLieu, "2026-10-01", "2026-10-07", 1, "2026-10-08", "2026-09-30"},
+		{"2026-10-12", DayTypeWorkday, "", "", 0, "2026-10-13", "2026-10-10"},
+		{"2026-10-10", DayTypeAdjustedWorkday, "", "", 0, "2026-10-12", "2026-10-09"},
+		{"2026-10-18", DayTypeWeekend, "", "", 0, "2026-10-19", "2026-10-16"},
+		{"2026-12-31", DayTypeWorkday, "", "", 0, "", "2026-12-30"}, // 2027 年没有数据
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			dc, err := checker.DayContext(date(tt.date).Add(15 * time.Hour))
+			if err != nil {
+				t.Fatalf("DayContext failed: %v", err)
+			}
+			if got := dc.Info.DayType(); got != tt.dayType {
+				t.Errorf("DayType = %v, want %v", got, tt.dayType)
+			}
+			if tt.breakStart == "" {
+				if dc.Break != nil {
+					t.Errorf("Break = %+v, want nil", dc.Break)
+				}
+			} else if dc.Break == nil || format(dc.Break.Start) != tt.breakStart || format(dc.Break.End) != tt.breakEnd {
+				t.Errorf("Break = %+v, want %s ~ %s", dc.Break, tt.breakStart, tt.breakEnd)
+			}
+			if dc.DaysRemaining != tt.remaining {
+				t.Errorf("DaysRemaining = %d, want %d", dc.DaysRemaining, tt.remaining)
+			}
+			if format(dc.NextWorkday) != tt.next || format(dc.PrevWorkday) != tt.prev {
+				t.Errorf("NextWorkday, PrevWorkday = %s, %s, want %s, %s", format(dc.NextWorkday), format(dc.PrevWorkday), tt.next, tt.prev)
+			}
+		})
+	}
+
+	// 所在放假与 HolidayBreaks 一致
+	breaks, err := checker.HolidayBreaks(2026)
+	if err != nil {
+		t.Fatalf("HolidayBreaks failed: %v", err)
+	}
+	for _, hb := range breaks {
+		dc, err := checker.DayContext(hb.Start)
+		if err != nil || dc.Break == nil || dc.Break.Days != hb.Days || len(dc.Break.Festivals) != len(hb.Festivals) {
+			t.Errorf("DayContext(%s).Break = %+v, %v, want %+v", hb.Start.Format(time.DateOnly), dc, err, hb)
+		}
+	}
+
+	if _, err := checker.DayContext(date("2027-03-01")); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
@@ -0,0 +1,270 @@
+package cnholiday
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DayType 日期类型
+type DayType uint8
+
+const (
+	DayTypeWorkday         DayType = iota // 普通工作日
+	DayTypeWeekend                        // 周末
+	DayTypePublicHoliday                  // 法定节假日放假期间
+	DayTypeAdjustedWorkday                // 调休工作日(周末上班)
+	DayTypeInLieu                         // 补休日(工作日放假)
+)
+
+// dayTypeLabels 日期类型的中文名称
+var dayTypeLabels = map[DayType]string{
+	DayTypeWorkday:         "工作日",
+	DayTypeWeekend:         "周末",
+	DayTypePublicHoliday:   "节假日",
+	DayTypeAdjustedWorkday: "调休工作日",
+	DayTypeInLieu:          "补休日",
+}
+
+// dayTypeCodes 日期类型的稳定编码，用于 JSON、消息和数据库，发布后不得修改
+var dayTypeCodes = map[DayType]string{
+	DayTypeWorkday:         "workday",
+	DayTypeWeekend:         "weekend",
+	DayTypePublicHoliday:   "public_holiday",
+	DayTypeAdjustedWorkday: "adjusted_workday",
+	DayTypeInLieu:          "in_lieu",
+}
+
+// customDayTypeBase 自定义日期类型的起始值，之前的值保留给内置类型
+const customDayTypeBase DayType = 32
+
+// customDayType 自定义日期类型的定义
+type customDayType struct {
+	code    string
+	workday bool // 该类型的日期是否需要上班，仅 fixed 为 true 时有意义
+	fixed   bool // 是否携带上班语义，日历层注册的类型携带，Custom 注册的类型只是标签
+}
+
+// customDayTypes 进程内注册的自定义日期类型，例如日历层中的"学期"、"寒假"
+// 自定义类型只追加不删除，同一编码始终对应同一个值
+var customDayTypes struct {
+	sync.RWMutex
+	byCode map[string]DayType
+	defs   []customDayType // 下标为 DayType - customDayTypeBase
+}
+
+// Custom 返回名为 name 的自定义日期类型，未注册时先注册，例如 Custom("盘点日")
+// 自定义类型只是标签，不携带上班语义：当天是否上班由使用它的覆盖规则决定，
+// 类型本身的 IsWorkday 返回 false。name 不能与内置编码或日历层中的类型编码重复
+func Custom(name string) (DayType, error) {
+	return registerCustomDayType(customDayType{code: name})
+}
+
+// registerDayType 注册携带上班语义的自定义日期类型并返回对应的值，编码已注册时返回已有的值
+// 编码不能与内置类型重复，同一编码的 workday 必须一致
+func registerDayType(code string, workday bool) (DayType, error) {
+	return registerCustomDayType(customDayType{code: code, workday: workday, fixed: true})
+}
+
+// registerCustomDayType 注册自定义日期类型，编码已按相同定义注册时返回已有的值
+func registerCustomDayType(def customDayType) (DayType, error) {
+	if def.code == "" {
+		return 0, errors.New("日期类型编码不能为空")
+	}
+	for _, c := range dayTypeCodes {
+		if c == def.code {
+			return 0, fmt.Errorf("日期类型编码 %q 与内置类型重复", def.code)
+		}
+	}
+
+	customDayTypes.Lock()
+	defer customDayTypes.Unlock()
+	if t, ok := customDayTypes.byCode[def.code]; ok {
+		if customDayTypes.defs[t-customDayTypeBase] != def {
+			return 0, fmt.Errorf("日期类型 %q 已注册为不同的工作日属性", def.code)
+		}
+		return t, nil
+	}
+	if len(customDayTypes.defs) > int(^DayType(0)-customDayTypeBase) {
+		return 0, errors.New("自定义日期类型数量超出上限")
+	}
+	if customDayTypes.byCode == nil {
+		customDayTypes.byCode = make(map[string]DayType)
+	}
+	t := customDayTypeBase + DayType(len(customDayTypes.defs))
+	customDayTypes.byCode[def.code] = t
+	customDayTypes.defs = append(customDayTypes.defs, def)
+	return t, nil
+}
+
+// custom 返回自定义日期类型的定义，内置或未注册的类型返回 false
+func (t DayType) custom() (customDayType, bool) {
+	if t < customDayTypeBase {
+		return customDayType{}, false
+	}
+	customDayTypes.RLock()
+	defer customDayTypes.RUnlock()
+	i := int(t - customDayTypeBase)
+	if i >= len(customDayTypes.defs) {
+		return customDayType{}, false
+	}
+	return customDayTypes.defs[i], true
+}
+
+// IsCustom 是否是通过 Custom 或日历层注册的自定义日期类型
+func (t DayType) IsCustom() bool {
+	_, ok := t.custom()
+	return ok
+}
+
+// String 返回日期类型的稳定编码，例如 "public_holiday"，自定义类型返回注册时的编码
+func (t DayType) String() string {
+	if code, ok := dayTypeCodes[t]; ok {
+		return code
+	}
+	if def, ok := t.custom(); ok {
+		return def.code
+	}
+	return fmt.Sprintf("DayType(%d)", uint8(t))
+}
+
+// ParseDayType 将稳定编码解析为日期类型，包括已注册的自定义类型
+func ParseDayType(code string) (DayType, error) {
+	for t, c := range dayTypeCodes {
+		if c == code {
+			return t, nil
+		}
+	}
+	customDayTypes.RLock()
+	t, ok := customDayTypes.byCode[code]
+	customDayTypes.RUnlock()
+	if ok {
+		return t, nil
+	}
+	return DayTypeWorkday, fmt.Errorf("未知的日期类型编码: %q", code)
+}
+
+// MarshalText 实现 encoding.TextMarshaler，JSON 中以稳定编码表示
+func (t DayType) MarshalText() ([]byte, error) {
+	if _, ok := dayTypeCodes[t]; !ok && !t.IsCustom() {
+		return nil, fmt.Errorf("未知的日期类型: %d", uint8(t))
+	}
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler
+func (t *DayType) UnmarshalText(text []byte) error {
+	parsed, err := ParseDayType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Value 实现 driver.Valuer，数据库中以稳定编码存储
+func (t DayType) Value() (driver.Value, error) {
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan 实现 sql.Scanner
+func (t *DayType) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	default:
+		return fmt.Errorf("无法将 %T 转换为日期类型", src)
+	}
+}
+
+// label 返回日期类型的中文名称，自定义类型返回其编码
+func (t DayType) label() string {
+	if label, ok := dayTypeLabels[t]; ok {
+		return label
+	}
+	if def, ok := t.custom(); ok {
+		return def.code
+	}
+	return fmt.Sprintf("未知类型(%d)", uint8(t))
+}
+
+// IsWorkday 该类型的日期是否需要上班，Custom 注册的类型不携带上班语义，返回 false
+func (t DayType) IsWorkday() bool {
+	if def, ok := t.custom(); ok {
+		return def.fixed && def.workday
+	}
+	return t == DayTypeWorkday || t == DayTypeAdjustedWorkday
+}
+
+// Classify 返回指定日期的类型及对应的节日名称
+// 开启 HeuristicFallback 时，缺少数据的日期按周末推断
+func (c *Checker) Classify(date time.Time) (DayType, string, error) {
+	dayType, name, _, err := c.classify(date)
+	return dayType, name, err
+}
+
+// IsWeekend 判断指定日期是否是普通周末(不在放假安排内且无需调休上班的周六、周日)
+// 与 HolidayInfo.IsWeekend 一致，假期内的周末返回 false
+func (c *Checker) IsWeekend(date time.Time) (bool, error) {
+	return c.isDayType(date, DayTypeWeekend)
+}
+
+// IsAdjustedWorkday 判断指定日期是否是调休工作日(周末上班)
+func (c *Checker) IsAdjustedWorkday(date time.Time) (bool, error) {
+	return c.isDayType(date, DayTypeAdjustedWorkday)
+}
+
+// IsInLieuDay 判断指定日期是否是补休日
+// 补休日是本应上班的周一至周五，因调休改为放假，用来补偿假期中占用的周末，
+// 通常与调休工作日一一对应。补休日属于放假日，IsHoliday 返回 true；
+// 数据中的日期只出现在 inLieuDays 而未出现在 holidays 中时同样视为补休日
+func (c *Checker) IsInLieuDay(date time.Time) (bool, error) {
+	return c.isDayType(date, DayTypeInLieu)
+}
+
+func (c *Checker) isDayType(date time.Time, want DayType) (bool, error) {
+	dayType, _, _, err := c.classify(date)
+	if err != nil {
+		return false, err
+	}
+	return dayType == want, nil
+}
+
+// DayType 返回节假日信息对应的日期类型
+func (h *HolidayInfo) DayType() DayType {
+	switch {
+	case h.CustomType.IsCustom():
+		return h.CustomType
+	case h.IsAdjustedWorkday:
+		return DayTypeAdjustedWorkday
+	case h.IsInLieuDay:
+		return DayTypeInLieu
+	case h.IsWeekend:
+		return DayTypeWeekend
+	case h.IsHoliday:
+		return DayTypePublicHoliday
+	}
+	return DayTypeWorkday
+}
+
+// isStatutoryHoliday 判断是否是法定节假日当天(计薪不出勤、加班按 300% 计算的日期)，dayType 与 name 为 Classify 的结果。
+// 按各节日的法定日期判断，落在周末的法定假日也算在内，因此顺延到周一至周五的放假日不算。
+// 名称只包含一个节日时法定天数以名称中 "英文名,中文名,法定天数" 的第三段为准，合并放假时按放假办法的规定
+func isStatutoryHoliday(t DayType, name string, date time.Time) bool {
+	if t != DayTypePublicHoliday {
+		return false
+	}
+	festivals := NormalizeFestival(name)
+	if n, ok := nameStatutoryDays(name); ok && len(festivals) == 1 {
+		return isStatutoryDateOf(festivals[0], date, n)
+	}
+	return isStatutoryDate(festivals, date)
+}
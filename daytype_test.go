@@ -0,0 +1,191 @@
+package cnholiday
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	tests := []struct {
+		date     string
+		expected DayType
+	}{
+		{"2026-10-01", DayTypePublicHoliday},
+		{"2026-10-03", DayTypePublicHoliday}, // 假期内的周六
+		{"2026-10-07", DayTypeInLieu},
+		{"2026-10-10", DayTypeAdjustedWorkday},
+		{"2026-10-17", DayTypeWeekend},
+		{"2026-10-12", DayTypeWorkday},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			date, _ := time.Parse("2006-01-02", tt.date)
+			got, _, err := checker.Classify(date)
+			if err != nil {
+				t.Fatalf("Classify failed: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Classify(%s) = %v, want %v", tt.date, got, tt.expected)
+			}
+			if got.IsWorkday() != (tt.expected == DayTypeWorkday || tt.expected == DayTypeAdjustedWorkday) {
+				t.Errorf("IsWorkday() mismatch for %v", got)
+			}
+		})
+	}
+}
+
+func TestIsStatutoryHoliday(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	// 2026 年的 13 天法定假日，含落在周末的 04-05、05-02、10-03
+	want := map[string]bool{
+		"2026-01-01": true, "2026-02-16": true, "2026-02-17": true, "2026-02-18": true, "2026-02-19": true,
+		"2026-04-05": true, "2026-05-01": true, "2026-05-02": true, "2026-06-19": true, "2026-09-25": true,
+		"2026-10-01": true, "2026-10-02": true, "2026-10-03": true,
+	}
+	for date := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.Local); date.Year() == 2026; date = date.AddDate(0, 0, 1) {
+		dayType, name, err := checker.Classify(date)
+		if err != nil {
+			t.Fatalf("Classify failed: %v", err)
+		}
+		key := date.Format(time.DateOnly)
+		if got := isStatutoryHoliday(dayType, name, date); got != want[key] {
+			t.Errorf("isStatutoryHoliday(%s) = %v, want %v", key, got, want[key])
+		}
+	}
+}
+
+func TestDayTypeCodes(t *testing.T) {
+	for dayType, code := range dayTypeCodes {
+		if got := dayType.String(); got != code {
+			t.Errorf("%d.String() = %q, want %q", dayType, got, code)
+		}
+		parsed, err := ParseDayType(code)
+		if err != nil || parsed != dayType {
+			t.Errorf("ParseDayType(%q) = %d, %v; want %d", code, parsed, err, dayType)
+		}
+	}
+
+	if _, err := ParseDayType("holiday"); err == nil {
+		t.Error("expected error for unknown code")
+	}
+	if got := DayType(99).String(); got != "DayType(99)" {
+		t.Errorf("DayType(99).String() = %q", got)
+	}
+}
+
+func TestDayTypeJSON(t *testing.T) {
+	type event struct {
+		Type DayType `json:"type"`
+	}
+	data, err := json.Marshal(event{Type: DayTypeInLieu})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if string(data) != `{"type":"in_lieu"}` {
+		t.Errorf("Marshal = %s", data)
+	}
+
+	var decoded event
+	if err := json.Unmarshal([]byte(`{"type":"adjusted_workday"}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if decoded.Type != DayTypeAdjustedWorkday {
+		t.Errorf("Unmarshal = %d, want %d", decoded.Type, DayTypeAdjustedWorkday)
+	}
+	if err := json.Unmarshal([]byte(`{"type":"unknown"}`), &decoded); err == nil {
+		t.Error("expected error for unknown code")
+	}
+
+	var scanned DayType
+	if err := scanned.Scan([]byte("weekend")); err != nil || scanned != DayTypeWeekend {
+		t.Errorf("Scan = %d, %v", scanned, err)
+	}
+	if v, err := DayTypePublicHoliday.Value(); err != nil || v != "public_holiday" {
+		t.Errorf("Value = %v, %v", v, err)
+	}
+}
+
+func TestDayTypePredicates(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	tests := []struct {
+		date                      string
+		weekend, adjusted, inLieu bool
+	}{
+		{"2026-10-03", false, false, false}, // 假期内的周六
+		{"2026-10-07", false, false, true},
+		{"2026-10-10", false, true, false},
+		{"2026-10-17", true, false, false},
+		{"2026-10-12", false, false, false},
+	}
+	for _, tt := range tests {
+		date, _ := time.Parse("2006-01-02", tt.date)
+		weekend, err := checker.IsWeekend(date)
+		if err != nil {
+			t.Fatalf("IsWeekend failed: %v", err)
+		}
+		adjusted, _ := checker.IsAdjustedWorkday(date)
+		inLieu, _ := checker.IsInLieuDay(date)
+		if weekend != tt.weekend || adjusted != tt.adjusted || inLieu != tt.inLieu {
+			t.Errorf("%s: IsWeekend=%v IsAdjustedWorkday=%v IsInLieuDay=%v; want %v %v %v",
+				tt.date, weekend, adjusted, inLieu, tt.weekend, tt.adjusted, tt.inLieu)
+		}
+	}
+
+	if _, err := checker.IsWeekend(time.Date(1990, 1, 6, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
+
+func TestCustomDayType(t *testing.T) {
+	term, err := registerDayType("test_term", true)
+	if err != nil {
+		t.Fatalf("registerDayType failed: %v", err)
+	}
+	if again, _ := registerDayType("test_term", true); again != term {
+		t.Errorf("re-registering returned %v, want %v", again, term)
+	}
+	if !term.IsCustom() || !term.IsWorkday() || term.String() != "test_term" {
+		t.Errorf("custom type = %v, IsCustom=%v IsWorkday=%v", term, term.IsCustom(), term.IsWorkday())
+	}
+	if DayTypeWeekend.IsCustom() {
+		t.Error("builtin types are not custom")
+	}
+
+	parsed, err := ParseDayType("test_term")
+	if err != nil || parsed != term {
+		t.Errorf("ParseDayType = %v, %v", parsed, err)
+	}
+	text, err := term.MarshalText()
+	if err != nil || string(text) != "test_term" {
+		t.Errorf("MarshalText = %q, %v", text, err)
+	}
+}
+
+func TestCustom(t *testing.T) {
+	label, err := Custom("test_label")
+	if err != nil {
+		t.Fatalf("Custom failed: %v", err)
+	}
+	if again, _ := Custom("test_label"); again != label {
+		t.Errorf("Custom returned %v, want %v", again, label)
+	}
+	if !label.IsCustom() || label.IsWorkday() {
+		t.Errorf("IsCustom=%v IsWorkday=%v", label.IsCustom(), label.IsWorkday())
+	}
+	if _, err := Custom("weekend"); err == nil {
+		t.Error("Custom should reject builtin codes")
+	}
+	// 标签类型与携带上班语义的日历层类型不能共用编码
+	if _, err := registerDayType("test_label", false); err == nil {
+		t.Error("registerDayType should reject a code registered by Custom")
+	}
+}
@@ -0,0 +1,96 @@
+package cnholiday
+
+import (
+	"log/slog"
+	"time"
+)
+
+// MetricsRecorder 查询指标的记录接口，可适配 Prometheus、expvar 等监控系统
+// method 为 HolidayChecker 的方法名，err 为查询返回的错误
+type MetricsRecorder interface {
+	ObserveQuery(method string, duration time.Duration, err error)
+}
+
+// MetricsRecorderFunc 将普通函数适配为 MetricsRecorder
+type MetricsRecorderFunc func(method string, duration time.Duration, err error)
+
+// ObserveQuery 实现 MetricsRecorder
+func (f MetricsRecorderFunc) ObserveQuery(method string, duration time.Duration, err error) {
+	f(method, duration, err)
+}
+
+// WithMetrics 返回记录每次查询耗时与错误的 HolidayChecker 装饰器
+func WithMetrics(next HolidayChecker, recorder MetricsRecorder) HolidayChecker {
+	return &observedChecker{next: next, observe: func(method string, _ []any, start time.Time, err error) {
+		recorder.ObserveQuery(method, time.Since(start), err)
+	}}
+}
+
+// WithLogging 返回记录每次查询的 HolidayChecker 装饰器
+// 成功的查询以 Debug 级别记录，失败的查询以 Warn 级别记录
+func WithLogging(next HolidayChecker, logger *slog.Logger) HolidayChecker {
+	return &observedChecker{next: next, observe: func(method string, attrs []any, start time.Time, err error) {
+		attrs = append(attrs, slog.String("method", method), slog.Duration("duration", time.Since(start)))
+		if err != nil {
+			logger.Warn("cnholiday 查询失败", append(attrs, slog.Any("error", err))...)
+			return
+		}
+		logger.Debug("cnholiday 查询", attrs...)
+	}}
+}
+
+// observedChecker 在每次查询完成后调用 observe 的装饰器
+type observedChecker struct {
+	next    HolidayChecker
+	observe func(method string, attrs []any, start time.Time, err error)
+}
+
+func dateAttr(key string, date time.Time) slog.Attr {
+	return slog.String(key, date.Format("2006-01-02"))
+}
+
+func (o *observedChecker) IsHoliday(date time.Time) (bool, string, error) {
+	start := time.Now()
+	isHoliday, name, err := o.next.IsHoliday(date)
+	o.observe("IsHoliday", []any{dateAttr("date", date), slog.Bool("result", isHoliday)}, start, err)
+	return isHoliday, name, err
+}
+
+func (o *observedChecker) IsWorkday(date time.Time) (bool, error) {
+	start := time.Now()
+	isWorkday, err := o.next.IsWorkday(date)
+	o.observe("IsWorkday", []any{dateAttr("date", date), slog.Bool("result", isWorkday)}, start, err)
+	return isWorkday, err
+}
+
+func (o *observedChecker) GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
+	start := time.Now()
+	info, err := o.next.GetHolidayInfo(date)
+	attrs := []any{dateAttr("date", date)}
+	if info != nil {
+		attrs = append(attrs, slog.String("result", info.DayType().String()))
+	}
+	o.observe("GetHolidayInfo", attrs, start, err)
+	return info, err
+}
+
+func (o *observedChecker) Classify(date time.Time) (DayType, string, error) {
+	start := time.Now()
+	dayType, name, err := o.next.Classify(date)
+	o.observe("Classify", []any{dateAttr("date", date), slog.String("result", dayType.String())}, start, err)
+	return dayType, name, err
+}
+
+func (o *observedChecker) CountWorkdaysBetween(startDate, endDate time.Time) (int, error) {
+	start := time.Now()
+	count, err := o.next.CountWorkdaysBetween(startDate, endDate)
+	o.observe("CountWorkdaysBetween", []any{dateAttr("start", startDate), dateAttr("end", endDate), slog.Int("result", count)}, start, err)
+	return count, err
+}
+
+func (o *observedChecker) AddWorkdays(date time.Time, n int) (time.Time, error) {
+	start := time.Now()
+	result, err := o.next.AddWorkdays(date, n)
+	o.observe("AddWorkdays", []any{dateAttr("date", date), slog.Int("n", n), dateAttr("result", result)}, start, err)
+	return result, err
+}
@@ -0,0 +1,55 @@
+package cnholiday
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithMetrics(t *testing.T) {
+	calls := map[string]int{}
+	var lastErr error
+	checker := WithMetrics(NewCheckerWithConfig(Config{DisableRemote: true}), MetricsRecorderFunc(
+		func(method string, duration time.Duration, err error) {
+			calls[method]++
+			lastErr = err
+		}))
+
+	checker.IsWorkday(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	checker.IsWorkday(time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC))
+	checker.Classify(time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC))
+	if calls["IsWorkday"] != 2 || calls["Classify"] != 1 {
+		t.Errorf("calls = %v", calls)
+	}
+
+	if _, err := checker.GetHolidayInfo(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Fatal("expected error for year without data")
+	}
+	if lastErr == nil {
+		t.Error("recorder did not receive the query error")
+	}
+}
+
+func TestWithLogging(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	checker := WithLogging(NewCheckerWithConfig(Config{DisableRemote: true}), logger)
+
+	if _, err := checker.AddWorkdays(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC), 1); err != nil {
+		t.Fatalf("AddWorkdays failed: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{"level=DEBUG", "method=AddWorkdays", "date=2026-09-30", "result=2026-10-08"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("log missing %q: %s", want, out)
+		}
+	}
+
+	buf.Reset()
+	checker.IsHoliday(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC))
+	if out := buf.String(); !strings.Contains(out, "level=WARN") || !strings.Contains(out, "error=") {
+		t.Errorf("failed query should be logged at WARN: %s", out)
+	}
+}
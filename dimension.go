@@ -0,0 +1,177 @@
+package cnholiday
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+)
+
+// DimensionRow 日期维度表中的一行，字段均为扁平的基本类型，便于写入 CSV、Parquet 等列式格式
+type DimensionRow struct {
+	DateKey           int       // YYYYMMDD 形式的整数主键，例如 20261001
+	Date              time.Time // 当天零点，DateDimension 为 time.Local，DateDimensionIn 为区间 Start 的时区
+	Year              int
+	Quarter           int
+	Month             int
+	Day               int
+	DayOfYear         int
+	Weekday           int // ISO 星期，周一为 1，周日为 7
+	ISOYear           int
+	ISOWeek           int
+	FiscalYear        int // 财年，起始月份由 Config.FiscalYearStart 决定
+	FiscalQuarter     int
+	DayType           DayType
+	HolidayName       string // 中文节日名称，普通工作日和周末为空
+	IsWorkday         bool
+	WorkdayOfMonth    int  // 当月第几个工作日，非工作日为 0
+	IsMonthEndWorkday bool // 是否是当月最后一个工作日
+}
+
+// dimensionColumns WriteDateDimensionCSV 输出的列名，与 DimensionRow 字段一一对应
+var dimensionColumns = []string{
+	"date_key", "date", "year", "quarter", "month", "day", "day_of_year", "weekday",
+	"iso_year", "iso_week", "fiscal_year", "fiscal_quarter", "day_type", "holiday_name",
+	"is_workday", "workday_of_month", "is_month_end_workday",
+}
+
+// DateDimension 生成 startYear 至 endYear 年(含)每一天的日期维度数据，按日期升序排列
+// 数据仓库中的日期维度表通常需要手工维护节假日列，可直接使用该结果生成；任意日期区间使用 DateDimensionIn
+func (c *Checker) DateDimension(startYear, endYear int) ([]DimensionRow, error) {
+	if endYear < startYear {
+		return nil, errors.New("结束年份早于开始年份")
+	}
+	return c.DateDimensionIn(DateRange{Start: YearRange(startYear).Start, End: YearRange(endYear).End})
+}
+
+// DateDimensionIn 生成区间内每一天的日期维度数据，按日期升序排列
+// WorkdayOfMonth、IsMonthEndWorkday 按整月计算，不受区间在月中开始或结束的影响
+func (c *Checker) DateDimensionIn(r DateRange) ([]DimensionRow, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	var rows []DimensionRow
+	for _, year := range r.Years() {
+		yearRows, err := c.dimensionYear(year)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range yearRows {
+			if r.Contains(row.Date) {
+				row.Date = time.Date(row.Year, time.Month(row.Month), row.Day, 0, 0, 0, 0, r.Start.Location())
+				rows = append(rows, row)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// dimensionYear 生成 year 年每一天的日期维度数据，日期为 time.Local
+func (c *Checker) dimensionYear(year int) ([]DimensionRow, error) {
+	idx, err := c.index(year)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []DimensionRow
+	monthStart, lastWorkday := 0, -1
+	day := time.Date(year, 1, 1, 0, 0, 0, 0, time.Local)
+	for day.Year() == year {
+		dayType, name := idx.lookup(day)
+		isoYear, isoWeek := day.ISOWeek()
+		fiscalYear, fiscalQuarter := c.FiscalYearOf(day)
+		weekday := int(day.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+
+		row := DimensionRow{
+			DateKey:       year*10000 + int(day.Month())*100 + day.Day(),
+			Date:          day,
+			Year:          year,
+			Quarter:       (int(day.Month())-1)/3 + 1,
+			Month:         int(day.Month()),
+			Day:           day.Day(),
+			DayOfYear:     day.YearDay(),
+			Weekday:       weekday,
+			ISOYear:       isoYear,
+			ISOWeek:       isoWeek,
+			FiscalYear:    fiscalYear,
+			FiscalQuarter: fiscalQuarter,
+			DayType:       dayType,
+			IsWorkday:     dayType.IsWorkday(),
+		}
+		if name != "" {
+			row.HolidayName = chineseName(name)
+		}
+		if row.IsWorkday {
+			row.WorkdayOfMonth = 1
+			if lastWorkday >= 0 {
+				row.WorkdayOfMonth = rows[lastWorkday].WorkdayOfMonth + 1
+			}
+			lastWorkday = len(rows)
+		}
+		rows = append(rows, row)
+
+		next := day.AddDate(0, 0, 1)
+		if next.Month() != day.Month() {
+			if lastWorkday >= monthStart {
+				rows[lastWorkday].IsMonthEndWorkday = true
+			}
+			monthStart, lastWorkday = len(rows), -1
+		}
+		day = next
+	}
+	return rows, nil
+}
+
+// WriteDateDimensionCSV 以 CSV 格式输出 DateDimension 的结果，首行为列名
+// 列名使用 snake_case，日期为 YYYY-MM-DD，日期类型为 DayType.String() 的代码，布尔值为 true/false
+func (c *Checker) WriteDateDimensionCSV(w io.Writer, startYear, endYear int) error {
+	rows, err := c.DateDimension(startYear, endYear)
+	if err != nil {
+		return err
+	}
+	return writeDimensionCSV(w, rows)
+}
+
+// WriteDateDimensionCSVIn 以 CSV 格式输出 DateDimensionIn 的结果，格式与 WriteDateDimensionCSV 相同
+func (c *Checker) WriteDateDimensionCSVIn(w io.Writer, r DateRange) error {
+	rows, err := c.DateDimensionIn(r)
+	if err != nil {
+		return err
+	}
+	return writeDimensionCSV(w, rows)
+}
+
+// writeDimensionCSV 以 CSV 格式输出日期维度数据，首行为列名
+func writeDimensionCSV(w io.Writer, rows []DimensionRow) error {
+
+	cw := csv.NewWriter(w)
+	cw.Write(dimensionColumns)
+	for _, row := range rows {
+		cw.Write([]string{
+			strconv.Itoa(row.DateKey),
+			row.Date.Format("2006-01-02"),
+			strconv.Itoa(row.Year),
+			strconv.Itoa(row.Quarter),
+			strconv.Itoa(row.Month),
+			strconv.Itoa(row.Day),
+			strconv.Itoa(row.DayOfYear),
+			strconv.Itoa(row.Weekday),
+			strconv.Itoa(row.ISOYear),
+			strconv.Itoa(row.ISOWeek),
+			strconv.Itoa(row.FiscalYear),
+			strconv.Itoa(row.FiscalQuarter),
+			row.DayType.String(),
+			row.HolidayName,
+			strconv.FormatBool(row.IsWorkday),
+			strconv.Itoa(row.WorkdayOfMonth),
+			strconv.FormatBool(row.IsMonthEndWorkday),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,90 @@
+package cnholiday
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+)
+
+func TestDateDimension(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true, FiscalYearStart: time.April})
+
+	rows, err := checker.DateDimension(2025, 2026)
+	if err != nil {
+		t.Fatalf("DateDimension failed: %v", err)
+	}
+	if len(rows) != 365*2 {
+		t.Fatalf("len(rows) = %d, want 730", len(rows))
+	}
+
+	byKey := make(map[int]DimensionRow, len(rows))
+	for _, row := range rows {
+		byKey[row.DateKey] = row
+	}
+
+	national := byKey[20261001]
+	if national.DayType != DayTypePublicHoliday || national.HolidayName != "国庆节" || national.IsWorkday {
+		t.Errorf("20261001 = %+v", national)
+	}
+	if national.Weekday != 4 || national.Quarter != 4 || national.FiscalYear != 2026 || national.FiscalQuarter != 3 {
+		t.Errorf("20261001 attributes = %+v", national)
+	}
+
+	// 10 月 10 日调休上班，是 10 月第 3 个工作日(前两个为 8 日、9 日)
+	if adjusted := byKey[20261010]; !adjusted.IsWorkday || adjusted.WorkdayOfMonth != 3 {
+		t.Errorf("20261010 = %+v, want 3rd workday of month", adjusted)
+	}
+	if last := byKey[20261030]; !last.IsMonthEndWorkday || last.WorkdayOfMonth != 18 {
+		t.Errorf("20261030 = %+v, want last (18th) workday of October", last)
+	}
+	if sat := byKey[20261031]; sat.IsMonthEndWorkday || sat.WorkdayOfMonth != 0 {
+		t.Errorf("20261031 = %+v, weekend should not be a workday", sat)
+	}
+
+	if _, err := checker.DateDimension(2026, 2025); err == nil {
+		t.Error("expected error when end year is before start year")
+	}
+	if _, err := checker.DateDimension(2026, 2099); err == nil {
+		t.Error("expected error for year without data")
+	}
+
+	// 跨年的区间，月内工作日序号按整月计算
+	loc := time.FixedZone("UTC+8", 8*3600)
+	r := DateRange{Start: time.Date(2025, 12, 30, 0, 0, 0, 0, loc), End: time.Date(2026, 1, 6, 0, 0, 0, 0, loc)}
+	rows, err = checker.DateDimensionIn(r)
+	if err != nil {
+		t.Fatalf("DateDimensionIn failed: %v", err)
+	}
+	if len(rows) != 8 || rows[0].DateKey != 20251230 || rows[7].DateKey != 20260106 || rows[0].Date.Location() != loc {
+		t.Fatalf("DateDimensionIn(%s) = %d rows, first %+v", r, len(rows), rows[0])
+	}
+	if rows[1].WorkdayOfMonth != byKey[20251231].WorkdayOfMonth || !rows[1].IsMonthEndWorkday {
+		t.Errorf("20251231 = %+v, want %+v", rows[1], byKey[20251231])
+	}
+	if _, err := checker.DateDimensionIn(DateRange{Start: r.End, End: r.Start}); err == nil {
+		t.Error("expected error when end is before start")
+	}
+}
+
+func TestWriteDateDimensionCSV(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var buf bytes.Buffer
+	if err := checker.WriteDateDimensionCSV(&buf, 2026, 2026); err != nil {
+		t.Fatalf("WriteDateDimensionCSV failed: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(records) != 366 || records[0][0] != "date_key" {
+		t.Fatalf("got %d records, header %v", len(records), records[0])
+	}
+
+	// 2026-10-01 是第 274 天
+	row := records[274]
+	if row[0] != "20261001" || row[12] != "public_holiday" || row[13] != "国庆节" || row[14] != "false" {
+		t.Errorf("20261001 record = %v", row)
+	}
+}
@@ -0,0 +1,126 @@
+package cnholiday
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sourceLabels 数据源在错误信息中的名称
+var sourceLabels = map[Source]string{
+	SourceRemote:       "远程",
+	SourceLocal:        "本地",
+	SourceEmbedded:     "嵌入数据",
+	SourceConfigCenter: "配置中心",
+	SourceNotice:       "官方通知",
+	SourceBundle:       "数据包",
+}
+
+// SourceError 单个数据源的加载错误
+type SourceError struct {
+	Source Source
+	Err    error
+}
+
+func (e *SourceError) Error() string {
+	label, ok := sourceLabels[e.Source]
+	if !ok {
+		label = string(e.Source)
+	}
+	return fmt.Sprintf("%s加载失败: %v", label, e.Err)
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// YearRangeError 年份超出 Config.MinYear ~ Config.MaxYear 的范围，不会请求任何数据源
+type YearRangeError struct {
+	Year int
+	Min  int
+	Max  int
+}
+
+func (e *YearRangeError) Error() string {
+	return fmt.Sprintf("%d 年超出支持的年份范围 %d ~ %d", e.Year, e.Min, e.Max)
+}
+
+// HTTPStatusError 远程地址返回了 200 以外的状态码
+type HTTPStatusError struct {
+	StatusCode int
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("HTTP 状态码 %d", e.StatusCode)
+}
+
+// QuarantineError 数据已经取得，但未通过解析或校验，被隔离而没有写入缓存
+// 缓存中已有的数据保持不变，被拒绝的原始数据保留在 Data 中便于排查
+type QuarantineError struct {
+	Year   int
+	Source Source
+	Data   []byte
+	Err    error
+}
+
+func (e *QuarantineError) Error() string {
+	return fmt.Sprintf("%d 年数据未通过校验，已隔离: %v", e.Year, e.Err)
+}
+
+func (e *QuarantineError) Unwrap() error {
+	return e.Err
+}
+
+// YearLoadError 年份数据加载失败，按尝试顺序记录回退链路中每个数据源的错误
+type YearLoadError struct {
+	Year   int
+	Errors []*SourceError
+}
+
+func (e *YearLoadError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("无法加载 %d 年的节假日数据: 未配置数据源", e.Year)
+	}
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("无法加载 %d 年的节假日数据: %s", e.Year, strings.Join(msgs, "; "))
+}
+
+func (e *YearLoadError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// LoadYearsError LoadYears 的聚合错误，按年份升序记录每个失败的年份
+type LoadYearsError struct {
+	Errors []*YearLoadError
+}
+
+func (e *LoadYearsError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d 个年份加载失败: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+func (e *LoadYearsError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// FailedYears 返回加载失败的年份列表
+func (e *LoadYearsError) FailedYears() []int {
+	years := make([]int, len(e.Errors))
+	for i, err := range e.Errors {
+		years[i] = err.Year
+	}
+	return years
+}
@@ -0,0 +1,72 @@
+package cnholiday
+
+import (
+	"errors"
+	"sync"
+)
+
+// DefaultEventQueueSize EventPublisher 等异步发送变更事件时默认的队列长度
+const DefaultEventQueueSize = 64
+
+var (
+	// ErrEventQueueFull 变更事件的发送队列已满，事件被丢弃
+	ErrEventQueueFull = errors.New("变更事件队列已满")
+	// ErrEventQueueClosed 发送队列已关闭，事件被丢弃
+	ErrEventQueueClosed = errors.New("变更事件队列已关闭")
+)
+
+// eventQueue 有界的变更事件队列，由单独的 goroutine 按入队顺序逐个处理，零值可用
+// 入队不阻塞，订阅回调不会因发送缓慢拖住触发变更的加载、修订或轮询
+type eventQueue struct {
+	once   sync.Once
+	mu     sync.Mutex // 保护 closed，避免向已关闭的 events 发送
+	closed bool
+	events chan ChangeEvent
+	done   chan struct{}
+}
+
+// push 将事件放入队列，首次调用时启动处理 goroutine；size 小于等于 0 时使用 DefaultEventQueueSize。
+// 队列已满或已关闭时丢弃事件并返回错误
+func (q *eventQueue) push(size int, event ChangeEvent, handle func(ChangeEvent)) error {
+	q.once.Do(func() {
+		if size <= 0 {
+			size = DefaultEventQueueSize
+		}
+		q.events = make(chan ChangeEvent, size)
+		q.done = make(chan struct{})
+		go func() {
+			defer close(q.done)
+			for event := range q.events {
+				handle(event)
+			}
+		}()
+	})
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return ErrEventQueueClosed
+	}
+	select {
+	case q.events <- event:
+		return nil
+	default:
+		return ErrEventQueueFull
+	}
+}
+
+// close 停止接收事件，并等待队列中已有的事件处理完，可以多次调用
+func (q *eventQueue) close() {
+	q.once.Do(func() {}) // 尚未启动时不再启动
+
+	q.mu.Lock()
+	if !q.closed && q.events != nil {
+		close(q.events)
+	}
+	q.closed = true
+	q.mu.Unlock()
+
+	if q.done != nil {
+		<-q.done
+	}
+}
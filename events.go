@@ -0,0 +1,223 @@
+package cnholiday
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// StatutoryCalendar Checker.Events 返回的法定节假日事件所属的日历名称
+const StatutoryCalendar = "statutory"
+
+// EventRule 营销日历中的日期规则，Date 不为零时为只发生一次的日期，否则按 Month/Day 或 Month/Nth/Weekday 每年重复
+type EventRule struct {
+	Name    string
+	Date    time.Time    // 只发生一次的日期，例如某年的店庆
+	Month   time.Month   // 每年重复的月份
+	Day     int          // 每年重复的日，例如双11 为 11
+	Nth     int          // Day 为 0 时使用：当月第 Nth 个 Weekday，负数表示倒数第几个
+	Weekday time.Weekday // 与 Nth 配合使用，例如母亲节为 5 月第 2 个星期日
+	Days    int          // 持续天数，默认 1
+}
+
+// ShoppingFestivals 内置的常见商业营销日期
+var ShoppingFestivals = []EventRule{
+	{Name: "情人节", Month: time.February, Day: 14},
+	{Name: "女神节", Month: time.March, Day: 8},
+	{Name: "520", Month: time.May, Day: 20},
+	{Name: "母亲节", Month: time.May, Nth: 2, Weekday: time.Sunday},
+	{Name: "618", Month: time.June, Day: 18},
+	{Name: "父亲节", Month: time.June, Nth: 3, Weekday: time.Sunday},
+	{Name: "双11", Month: time.November, Day: 11},
+	{Name: "双12", Month: time.December, Day: 12},
+	{Name: "圣诞节", Month: time.December, Day: 25},
+}
+
+// Event 日历中的一个事件，Start 与 End 为闭区间
+type Event struct {
+	Name     string
+	Calendar string // 所属日历名称，法定节假日为 StatutoryCalendar
+	Start    time.Time
+	End      time.Time
+}
+
+// EventCalendar 命名的营销日历，可以与法定节假日一起通过 Checker.Events 查询
+type EventCalendar struct {
+	Name  string
+	Rules []EventRule
+}
+
+// NewShoppingCalendar 返回包含 ShoppingFestivals 的营销日历，名称为 "shopping"
+func NewShoppingCalendar() *EventCalendar {
+	return &EventCalendar{Name: "shopping", Rules: slices.Clone(ShoppingFestivals)}
+}
+
+// eventRuleJSON 自定义营销日历 JSON 中的单条规则
+type eventRuleJSON struct {
+	Name    string `json:"name"`
+	Date    string `json:"date,omitempty"` // YYYY-MM-DD，只发生一次
+	Month   int    `json:"month,omitempty"`
+	Day     int    `json:"day,omitempty"`
+	Nth     int    `json:"nth,omitempty"`
+	Weekday int    `json:"weekday,omitempty"` // 0 为星期日
+	Days    int    `json:"days,omitempty"`
+}
+
+// ParseEventCalendar 解析 JSON 格式的自定义营销日历，格式为
+//
+//	{"events": [
+//	  {"name": "店庆", "date": "2026-09-09", "days": 3},
+//	  {"name": "双11", "month": 11, "day": 11},
+//	  {"name": "母亲节", "month": 5, "nth": 2, "weekday": 0}
+//	]}
+func ParseEventCalendar(name string, data []byte) (*EventCalendar, error) {
+	var doc struct {
+		Events []eventRuleJSON `json:"events"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("解析 JSON 失败: %w", err)
+	}
+
+	cal := &EventCalendar{Name: name}
+	for i, e := range doc.Events {
+		rule := EventRule{
+			Name:    e.Name,
+			Month:   time.Month(e.Month),
+			Day:     e.Day,
+			Nth:     e.Nth,
+			Weekday: time.Weekday(e.Weekday),
+			Days:    e.Days,
+		}
+		if e.Date != "" {
+			date, err := time.ParseInLocation("2006-01-02", e.Date, time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("events[%d]: 日期格式无效: %s", i, e.Date)
+			}
+			rule.Date = date
+		}
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("events[%d]: %w", i, err)
+		}
+		cal.Rules = append(cal.Rules, rule)
+	}
+	return cal, nil
+}
+
+// validate 检查规则是否完整
+func (r EventRule) validate() error {
+	switch {
+	case r.Name == "":
+		return errors.New("名称不能为空")
+	case r.Days < 0:
+		return errors.New("持续天数不能为负数")
+	case !r.Date.IsZero():
+		return nil
+	case r.Month < time.January || r.Month > time.December:
+		return errors.New("月份无效")
+	case r.Day == 0 && (r.Nth == 0 || r.Nth < -5 || r.Nth > 5 || r.Weekday < time.Sunday || r.Weekday > time.Saturday):
+		return errors.New("需要指定日期或第几个星期几")
+	case r.Day < 0 || r.Day > 31:
+		return errors.New("日期无效")
+	}
+	return nil
+}
+
+// occurrence 返回规则在 year 年的开始日期，当年不发生时返回 false
+func (r EventRule) occurrence(year int) (time.Time, bool) {
+	if !r.Date.IsZero() {
+		return r.Date, r.Date.Year() == year
+	}
+	if r.Day > 0 {
+		date := time.Date(year, r.Month, r.Day, 0, 0, 0, 0, time.Local)
+		return date, date.Month() == r.Month // 2 月 30 日等不存在的日期不发生
+	}
+
+	var date time.Time
+	if r.Nth > 0 {
+		first := time.Date(year, r.Month, 1, 0, 0, 0, 0, time.Local)
+		date = first.AddDate(0, 0, (int(r.Weekday)-int(first.Weekday())+7)%7+(r.Nth-1)*7)
+	} else {
+		last := time.Date(year, r.Month+1, 0, 0, 0, 0, 0, time.Local)
+		date = last.AddDate(0, 0, -(int(last.Weekday())-int(r.Weekday)+7)%7+(r.Nth+1)*7)
+	}
+	return date, date.Month() == r.Month
+}
+
+// Events 返回与 [start, end] 闭区间有交集的全部事件，按开始日期排列
+func (cal *EventCalendar) Events(start, end time.Time) []Event {
+	start, end = truncateDay(start), truncateDay(end)
+	var events []Event
+	for year := start.Year() - 1; year <= end.Year(); year++ {
+		for _, rule := range cal.Rules {
+			first, ok := rule.occurrence(year)
+			if !ok {
+				continue
+			}
+			first = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, start.Location())
+			last := first.AddDate(0, 0, max(rule.Days, 1)-1)
+			if last.Before(start) || first.After(end) {
+				continue
+			}
+			events = append(events, Event{Name: rule.Name, Calendar: cal.Name, Start: first, End: last})
+		}
+	}
+	sortEvents(events)
+	return events
+}
+
+// Events 返回 [start, end] 闭区间内的法定节假日放假期间以及 calendars 中的营销事件，按开始日期排列，
+// 便于促销排期等系统通过同一个接口同时查询法定节假日和商业节点
+// 放假期间按节日合并为连续区间(含补休日)，与区间边界相交的部分会被截断；
+// 多个节日合并放假时每个节日单独返回一个事件，区间为该节日在本次放假中的子区间。其他边界使用 EventsIn
+func (c *Checker) Events(start, end time.Time, calendars ...*EventCalendar) ([]Event, error) {
+	return c.EventsIn(DateRange{Start: start, End: end}, calendars...)
+}
+
+// EventsIn 返回与区间有交集的法定节假日放假期间以及 calendars 中的营销事件，参见 Events
+func (c *Checker) EventsIn(r DateRange, calendars ...*EventCalendar) ([]Event, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if r.empty() {
+		return nil, nil
+	}
+	start, end := r.first(), r.last()
+
+	breaks, err := c.holidayBreaks(start, end)
+	if err != nil {
+		return nil, err
+	}
+	var events []Event
+	for _, b := range breaks {
+		for _, p := range b.Festivals {
+			events = append(events, Event{Name: p.Name, Calendar: StatutoryCalendar, Start: p.Start, End: p.End})
+		}
+	}
+
+	for _, cal := range calendars {
+		events = append(events, cal.Events(start, end)...)
+	}
+	sortEvents(events)
+	return events, nil
+}
+
+// sortEvents 按开始日期排序，开始日期相同时法定节假日在前，其余按日历名称和事件名称排序
+func sortEvents(events []Event) {
+	rank := func(e Event) int {
+		if e.Calendar == StatutoryCalendar {
+			return 0
+		}
+		return 1
+	}
+	slices.SortStableFunc(events, func(a, b Event) int {
+		return cmp.Or(
+			a.Start.Compare(b.Start),
+			cmp.Compare(rank(a), rank(b)),
+			cmp.Compare(a.Calendar, b.Calendar),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+}
@@ -0,0 +1,103 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShoppingCalendar(t *testing.T) {
+	cal := NewShoppingCalendar()
+	events := cal.Events(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC))
+	if len(events) != len(ShoppingFestivals) {
+		t.Fatalf("got %d events, want %d", len(events), len(ShoppingFestivals))
+	}
+
+	got := make(map[string]string)
+	for _, e := range events {
+		if e.Calendar != "shopping" || !e.Start.Equal(e.End) {
+			t.Errorf("event = %+v", e)
+		}
+		got[e.Name] = e.Start.Format("2006-01-02")
+	}
+	for name, want := range map[string]string{"双11": "2026-11-11", "618": "2026-06-18", "母亲节": "2026-05-10", "父亲节": "2026-06-21"} {
+		if got[name] != want {
+			t.Errorf("%s = %s, want %s", name, got[name], want)
+		}
+	}
+}
+
+func TestParseEventCalendar(t *testing.T) {
+	cal, err := ParseEventCalendar("store", []byte(`{"events": [
+		{"name": "店庆", "date": "2026-09-09", "days": 3},
+		{"name": "年中大促", "month": 6, "day": 1, "days": 18},
+		{"name": "月末清仓", "month": 11, "nth": -1, "weekday": 5}
+	]}`))
+	if err != nil {
+		t.Fatalf("ParseEventCalendar failed: %v", err)
+	}
+
+	// 与区间有交集的多日事件也会返回
+	events := cal.Events(time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC), time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC))
+	want := []struct{ name, start, end string }{
+		{"年中大促", "2026-06-01", "2026-06-18"},
+		{"店庆", "2026-09-09", "2026-09-11"},
+		{"月末清仓", "2026-11-27", "2026-11-27"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		e := events[i]
+		if e.Name != w.name || e.Start.Format("2006-01-02") != w.start || e.End.Format("2006-01-02") != w.end || e.Calendar != "store" {
+			t.Errorf("events[%d] = %+v, want %+v", i, e, w)
+		}
+	}
+
+	for _, data := range []string{
+		`{"events": [{"month": 6, "day": 1}]}`,
+		`{"events": [{"name": "x", "date": "2026/01/01"}]}`,
+		`{"events": [{"name": "x", "month": 13, "day": 1}]}`,
+		`{"events": [{"name": "x", "month": 5}]}`,
+		`{"events": `,
+	} {
+		if _, err := ParseEventCalendar("bad", []byte(data)); err == nil {
+			t.Errorf("ParseEventCalendar(%s) expected error", data)
+		}
+	}
+}
+
+func TestCheckerEvents(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	store := &EventCalendar{Name: "store", Rules: []EventRule{{Name: "国庆大促", Month: time.October, Day: 1, Days: 7}}}
+
+	events, err := checker.Events(time.Date(2026, 9, 26, 0, 0, 0, 0, time.UTC), time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC), NewShoppingCalendar(), store)
+	if err != nil {
+		t.Fatalf("Events failed: %v", err)
+	}
+	want := []struct{ name, calendar, start, end string }{
+		{"中秋", StatutoryCalendar, "2026-09-26", "2026-09-27"}, // 截断到区间起点
+		{"国庆节", StatutoryCalendar, "2026-10-01", "2026-10-07"},
+		{"国庆大促", "store", "2026-10-01", "2026-10-07"},
+		{"双11", "shopping", "2026-11-11", "2026-11-11"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		e := events[i]
+		if e.Name != w.name || e.Calendar != w.calendar || e.Start.Format("2006-01-02") != w.start || e.End.Format("2006-01-02") != w.end {
+			t.Errorf("events[%d] = %+v, want %+v", i, e, w)
+		}
+	}
+
+	if _, err := checker.Events(time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error when end is before start")
+	}
+
+	// 不包含开始日期 9 月 27 日，中秋假期不在区间内
+	r := DateRange{Start: time.Date(2026, 9, 27, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), Bounds: BoundsOpenClosed}
+	events, err = checker.EventsIn(r)
+	if err != nil || len(events) != 1 || events[0].Name != "国庆节" || events[0].End.Format("2006-01-02") != "2026-10-01" {
+		t.Errorf("EventsIn(%s) = %+v, %v", r, events, err)
+	}
+}
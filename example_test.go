@@ -0,0 +1,142 @@
+package cnholiday_test
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/luojiego/cnholiday"
+)
+
+// 示例均使用内嵌数据或 testdata 中的固定数据，不访问网络，输出稳定
+
+func Example() {
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{DisableRemote: true})
+
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	isHoliday, name, err := checker.IsHoliday(date)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(isHoliday, name)
+	// Output: true National Day,国庆节,3
+}
+
+func ExampleNewCheckerWithConfig() {
+	// 离线模式：禁用远程 CDN，从本地目录读取数据文件
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{
+		DisableRemote: true,
+		LocalDataDir:  "testdata",
+	})
+	if err := checker.LoadYear(2030); err != nil {
+		panic(err)
+	}
+
+	report, _ := checker.LoadReport(2030)
+	fmt.Println("source:", report.Source)
+
+	info, _ := checker.GetHolidayInfo(time.Date(2030, 9, 29, 0, 0, 0, 0, time.Local))
+	fmt.Println(info.IsAdjustedWorkday)
+	// Output:
+	// source: local
+	// true
+}
+
+func ExampleChecker_CountWorkdaysBetween() {
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{DisableRemote: true})
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2026, 10, 31, 0, 0, 0, 0, time.Local)
+	count, err := checker.CountWorkdaysBetween(start, end)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(count)
+	// Output: 18
+}
+
+func ExampleChecker_WorkdaysBefore() {
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{DisableRemote: true})
+
+	// 截止日前至少 5 个工作日提交
+	deadline := time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local)
+	latest, err := checker.WorkdaysBefore(deadline, 5)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(latest.Format("2006-01-02"))
+	// Output: 2026-09-29
+}
+
+func ExampleChecker_Classify() {
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{DisableRemote: true})
+
+	for _, day := range []int{1, 7, 10, 12} {
+		dayType, _, err := checker.Classify(time.Date(2026, 10, day, 0, 0, 0, 0, time.Local))
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(day, dayType)
+	}
+	// Output:
+	// 1 public_holiday
+	// 7 in_lieu
+	// 10 adjusted_workday
+	// 12 workday
+}
+
+func ExampleChecker_RenderMonth() {
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{DisableRemote: true})
+
+	var b strings.Builder
+	if err := checker.RenderMonth(&b, 2026, time.October, cnholiday.RenderOptions{}); err != nil {
+		panic(err)
+	}
+	// 去除行尾空格，便于比较输出
+	for _, line := range strings.Split(strings.TrimSpace(b.String()), "\n") {
+		fmt.Println(strings.TrimRight(line, " "))
+	}
+	// Output:
+	//             2026年10月
+	//   一   二   三   四   五   六   日
+	//                  1休  2休  3休  4休
+	//   5休  6休  7休  8    9   10班 11
+	//  12   13   14   15   16   17   18
+	//  19   20   21   22   23   24   25
+	//  26   27   28   29   30   31
+	//
+	// 国庆节: 1-7日 放假 10日 上班
+}
+
+func ExampleChecker_WriteICS() {
+	checker := cnholiday.NewCheckerWithConfig(cnholiday.Config{
+		DisableRemote: true,
+		LocalDataDir:  "testdata",
+	})
+
+	f, err := os.CreateTemp("", "cnholiday-*.ics")
+	if err != nil {
+		panic(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := checker.WriteICS(f, 2030); err != nil {
+		panic(err)
+	}
+
+	// 只打印事件摘要，DTSTAMP 随导出时间变化
+	f.Seek(0, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); strings.HasPrefix(line, "SUMMARY:") {
+			fmt.Println(line)
+		}
+	}
+	// Output:
+	// SUMMARY:元旦 放假
+	// SUMMARY:国庆节 调休上班
+	// SUMMARY:国庆节 放假
+}
@@ -0,0 +1,110 @@
+package cnholiday
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// excelEpoch Excel 1900 日期系统中序列号 0 对应的日期。
+// Excel 沿用了 Lotus 1-2-3 将 1900 年视为闰年的错误，序列号 60 对应不存在的 1900-02-29，
+// 因此从序列号 61(1900-03-01) 起按 1899-12-30 起算
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// ExcelSerial 将日期转换为 Excel 1900 日期系统的序列号，小数部分表示一天中的时间
+func ExcelSerial(t time.Time) float64 {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	serial := math.Round(day.Sub(excelEpoch).Hours() / 24)
+	if serial < 61 {
+		serial-- // 1900-03-01 之前没有虚构的 2 月 29 日
+	}
+	h, min, sec := t.Clock()
+	seconds := float64(h*3600+min*60+sec) + float64(t.Nanosecond())/1e9
+	return serial + seconds/86400
+}
+
+// FromExcelSerial 将 Excel 1900 日期系统的序列号转换为 loc 时区的时间
+// 序列号 60(Excel 中虚构的 1900-02-29) 以及小于 1 的序列号无法转换
+func FromExcelSerial(serial float64, loc *time.Location) (time.Time, error) {
+	days := math.Floor(serial)
+	if days < 1 || days == 60 || math.IsNaN(serial) || math.IsInf(serial, 0) {
+		return time.Time{}, fmt.Errorf("无效的 Excel 日期序列号: %v", serial)
+	}
+	if days < 60 {
+		days++
+	}
+	date := excelEpoch.AddDate(0, 0, int(days))
+	seconds := math.Round((serial - math.Floor(serial)) * 86400)
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, int(seconds), 0, loc), nil
+}
+
+// ExcelWorkday 与 Excel 的 WORKDAY 语义一致：返回 start 之后(不含 start)第 days 个工作日，
+// days 为负数时向前查找，为 0 时返回 start；holidays 为额外排除的日期，时间部分被忽略
+func (c *Checker) ExcelWorkday(start time.Time, days int, holidays ...time.Time) (time.Time, error) {
+	excluded := make(map[[10]byte]bool, len(holidays))
+	for _, h := range holidays {
+		excluded[dateKey(h)] = true
+	}
+
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	date := truncateDay(start)
+	for days > 0 {
+		date = date.AddDate(0, 0, step)
+		isWorkday, err := c.IsWorkday(date)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if isWorkday && !excluded[dateKey(date)] {
+			days--
+		}
+	}
+	return date, nil
+}
+
+// NetWorkdays 与 Excel 的 NETWORKDAYS 语义一致：统计 start 与 end 之间(两端均包含)的工作日天数，
+// start 晚于 end 时结果为负数；holidays 为额外排除的日期，重复或不是工作日的日期不影响结果。
+// 与 Excel 不同的是，工作日按中国节假日安排判断(调休工作日计入)，而不是固定的周一至周五。
+// 其他边界使用 NetWorkdaysIn
+func (c *Checker) NetWorkdays(start, end time.Time, holidays ...time.Time) (int, error) {
+	sign := 1
+	if end.Before(start) {
+		start, end, sign = end, start, -1
+	}
+	count, err := c.NetWorkdaysIn(DateRange{Start: start, End: end}, holidays...)
+	return sign * count, err
+}
+
+// NetWorkdaysIn 统计区间内除 holidays 以外的工作日天数，holidays 的含义与 NetWorkdays 相同
+// 区间不能反向，结束日期早于开始日期时返回 ErrInvalidRange
+func (c *Checker) NetWorkdaysIn(r DateRange, holidays ...time.Time) (int, error) {
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	excluded := make(map[[10]byte]bool, len(holidays))
+	for _, h := range holidays {
+		excluded[dateKey(h)] = true
+	}
+
+	count := 0
+	for date := range r.All() {
+		isWorkday, err := c.IsWorkday(date)
+		if err != nil {
+			return 0, err
+		}
+		if isWorkday && !excluded[dateKey(date)] {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// truncateDay 去掉时间部分，与 Excel 忽略日期序列号小数部分的行为一致
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
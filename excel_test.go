@@ -0,0 +1,106 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNetWorkdays(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	tests := []struct {
+		start, end string
+		holidays   []time.Time
+		want       int
+	}{
+		{"2026-10-01", "2026-10-31", nil, 18},
+		{"2026-10-31", "2026-10-01", nil, -18},
+		{"2026-10-12", "2026-10-12", nil, 1},
+		{"2026-10-11", "2026-10-11", nil, 0},
+		{"2026-10-01", "2026-10-31", []time.Time{day("2026-10-12"), day("2026-10-12"), day("2026-10-17")}, 17},
+	}
+	for _, tt := range tests {
+		got, err := checker.NetWorkdays(day(tt.start), day(tt.end), tt.holidays...)
+		if err != nil {
+			t.Fatalf("NetWorkdays(%s, %s) failed: %v", tt.start, tt.end, err)
+		}
+		if got != tt.want {
+			t.Errorf("NetWorkdays(%s, %s, %d holidays) = %d, want %d", tt.start, tt.end, len(tt.holidays), got, tt.want)
+		}
+	}
+
+	// 时间部分被忽略
+	got, err := checker.NetWorkdays(time.Date(2026, 10, 12, 18, 0, 0, 0, time.UTC), time.Date(2026, 10, 13, 9, 0, 0, 0, time.UTC))
+	if err != nil || got != 2 {
+		t.Errorf("NetWorkdays() with time of day = %d, %v; want 2", got, err)
+	}
+
+	r := DateRange{Start: day("2026-10-12"), End: day("2026-10-19"), Bounds: BoundsClosedOpen}
+	if got, err := checker.NetWorkdaysIn(r, day("2026-10-13")); err != nil || got != 4 {
+		t.Errorf("NetWorkdaysIn(%s) = %d, %v; want 4", r, got, err)
+	}
+	if _, err := checker.NetWorkdaysIn(DateRange{Start: day("2026-10-31"), End: day("2026-10-01")}); err == nil {
+		t.Error("expected error when end is before start")
+	}
+}
+
+func TestExcelSerial(t *testing.T) {
+	tests := []struct {
+		date   time.Time
+		serial float64
+	}{
+		{time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), 1},
+		{time.Date(1900, 2, 28, 0, 0, 0, 0, time.UTC), 59},
+		{time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), 61},
+		{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), 46296},
+		{time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), 46296.5},
+	}
+	for _, tt := range tests {
+		if got := ExcelSerial(tt.date); got != tt.serial {
+			t.Errorf("ExcelSerial(%s) = %v, want %v", tt.date, got, tt.serial)
+		}
+		back, err := FromExcelSerial(tt.serial, time.UTC)
+		if err != nil {
+			t.Fatalf("FromExcelSerial(%v) failed: %v", tt.serial, err)
+		}
+		if !back.Equal(tt.date) {
+			t.Errorf("FromExcelSerial(%v) = %s, want %s", tt.serial, back, tt.date)
+		}
+	}
+
+	for _, bad := range []float64{0, 60, -1} {
+		if _, err := FromExcelSerial(bad, time.UTC); err == nil {
+			t.Errorf("FromExcelSerial(%v) should fail", bad)
+		}
+	}
+}
+
+func TestExcelWorkday(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	start := time.Date(2026, 9, 30, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		days     int
+		holidays []time.Time
+		want     string
+	}{
+		{0, nil, "2026-09-30"},
+		{1, nil, "2026-10-08"},
+		{3, nil, "2026-10-10"},
+		{3, []time.Time{time.Date(2026, 10, 9, 0, 0, 0, 0, time.UTC)}, "2026-10-12"},
+		{-2, nil, "2026-09-28"},
+	}
+	for _, tt := range tests {
+		got, err := checker.ExcelWorkday(start, tt.days, tt.holidays...)
+		if err != nil {
+			t.Fatalf("ExcelWorkday(%d) failed: %v", tt.days, err)
+		}
+		if got.Format("2006-01-02 15:04") != tt.want+" 00:00" {
+			t.Errorf("ExcelWorkday(%d) = %s, want %s", tt.days, got.Format("2006-01-02 15:04"), tt.want)
+		}
+	}
+}
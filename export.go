@@ -0,0 +1,42 @@
+package cnholiday
+
+import (
+	"maps"
+	"slices"
+)
+
+// Export 导出所有已缓存年份的数据，返回值为深拷贝，修改不会影响检查器
+func (c *Checker) Export() map[int]*HolidayData {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	result := make(map[int]*HolidayData, len(c.cache))
+	for year, idx := range c.cache {
+		result[year] = idx.holidayData()
+	}
+	return result
+}
+
+// Import 导入 Export 导出的数据，用于将一个检查器预热后的数据复制到其他检查器，无需重新获取
+// 已缓存的同一年份会被覆盖，值为 nil 的年份会被忽略
+func (c *Checker) Import(data map[int]*HolidayData) {
+	for year, yearData := range data {
+		if yearData == nil {
+			continue
+		}
+		c.store(year, yearData, SourceImport, nil)
+	}
+}
+
+// SortedDates 返回 data 中出现的全部日期(YYYY-MM-DD)，按日期升序排列并去重
+// map 的遍历顺序不固定，需要逐日处理数据或生成逐字节稳定的输出时应先调用该函数
+func SortedDates(data *HolidayData) []string {
+	if data == nil {
+		return nil
+	}
+	dates := slices.Collect(maps.Keys(data.Holidays))
+	dates = slices.AppendSeq(dates, maps.Keys(data.Workdays))
+	dates = slices.AppendSeq(dates, maps.Keys(data.InLieuDays))
+	slices.Sort(dates)
+	return slices.Compact(dates)
+}
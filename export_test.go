@@ -0,0 +1,100 @@
+package cnholiday
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestExportImport(t *testing.T) {
+	bootstrap := NewCheckerWithConfig(Config{DisableRemote: true})
+	for _, year := range []int{2025, 2026} {
+		if err := bootstrap.LoadYear(year); err != nil {
+			t.Fatalf("LoadYear(%d) failed: %v", year, err)
+		}
+	}
+
+	exported := bootstrap.Export()
+	if len(exported) != 2 {
+		t.Fatalf("len(Export()) = %d, want 2", len(exported))
+	}
+
+	// 修改导出结果不影响原检查器
+	exported[2026].Holidays["2026-10-12"] = "modified"
+	isHoliday, _, _ := bootstrap.IsHoliday(time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC))
+	if isHoliday {
+		t.Error("Export should return a deep copy")
+	}
+	delete(exported[2026].Holidays, "2026-10-12")
+
+	tenant := NewCheckerWithConfig(Config{DisableRemote: true})
+	tenant.Import(exported)
+	tenant.Import(map[int]*HolidayData{2030: nil})
+
+	if tenant.IsYearLoaded(2030) {
+		t.Error("nil entries should be ignored")
+	}
+	if !reflect.DeepEqual(tenant.Export(), bootstrap.Export()) {
+		t.Error("imported data should match exported data")
+	}
+	report, ok := tenant.LoadReport(2026)
+	if !ok || report.Source != SourceImport {
+		t.Errorf("LoadReport source = %v, want %s", report.Source, SourceImport)
+	}
+}
+
+func TestSortedDates(t *testing.T) {
+	data := &HolidayData{
+		Holidays:   map[string]string{"2026-10-02": "国庆节", "2026-10-01": "国庆节", "2026-10-07": "国庆节"},
+		Workdays:   map[string]string{"2026-10-10": "国庆节", "2026-09-20": "国庆节"},
+		InLieuDays: map[string]string{"2026-10-07": "国庆节"},
+	}
+	want := []string{"2026-09-20", "2026-10-01", "2026-10-02", "2026-10-07", "2026-10-10"}
+	if got := SortedDates(data); !reflect.DeepEqual(got, want) {
+		t.Errorf("SortedDates = %v, want %v", got, want)
+	}
+	if got := SortedDates(nil); got != nil {
+		t.Errorf("SortedDates(nil) = %v, want nil", got)
+	}
+}
+
+// TestDeterministicOutput 各导出函数对同一份数据的输出逐字节稳定，与加载顺序无关
+func TestDeterministicOutput(t *testing.T) {
+	render := func(years ...int) map[string]string {
+		checker := NewCheckerWithConfig(Config{DisableRemote: true})
+		for _, year := range years {
+			if err := checker.LoadYear(year); err != nil {
+				t.Fatalf("LoadYear(%d) failed: %v", year, err)
+			}
+		}
+		out := make(map[string]string)
+		var b bytes.Buffer
+		for name, write := range map[string]func() error{
+			"ics":       func() error { return checker.WriteICS(&b, 2025) },
+			"holidaycn": func() error { return checker.WriteHolidayCN(&b, 2025) },
+			"python":    func() error { return checker.WriteChineseCalendar(&b, 2026, 2025) },
+			"dimension": func() error { return checker.WriteDateDimensionCSV(&b, 2025, 2026) },
+			"sql":       func() error { return checker.WriteSQLUpsert(&b, SQLDialectPostgres, "cn_calendar", 2025, 2026) },
+		} {
+			b.Reset()
+			if err := write(); err != nil {
+				t.Fatalf("%s failed: %v", name, err)
+			}
+			out[name] = b.String()
+		}
+		return out
+	}
+
+	first := render(2025, 2026)
+	for range 3 {
+		if second := render(2026, 2025); !reflect.DeepEqual(first, second) {
+			for name := range first {
+				if first[name] != second[name] {
+					t.Errorf("%s output is not deterministic", name)
+				}
+			}
+			return
+		}
+	}
+}
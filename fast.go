@@ -0,0 +1,47 @@
+package cnholiday
+
+import "time"
+
+// dateKey 返回 2006-01-02 格式的日期键
+// 以定长数组返回，配合 m[string(key[:])] 查询 map 时不会产生堆分配；
+// 年份超出 0-9999 时返回空键，不会匹配任何数据
+func dateKey(date time.Time) [10]byte {
+	var key [10]byte
+	y, m, d := date.Date()
+	if y < 0 || y > 9999 {
+		return key
+	}
+	key[0] = byte('0' + y/1000)
+	key[1] = byte('0' + y/100%10)
+	key[2] = byte('0' + y/10%10)
+	key[3] = byte('0' + y%10)
+	key[4] = '-'
+	key[5] = byte('0' + int(m)/10)
+	key[6] = byte('0' + int(m)%10)
+	key[7] = '-'
+	key[8] = byte('0' + d/10)
+	key[9] = byte('0' + d%10)
+	return key
+}
+
+// dayNumber 返回日期(按其所在时区的年月日)距 1970-01-01 的天数
+// 只取 time.Time 的年月日，不经过 Format 生成字符串，不产生内存分配；
+// 不同时区表示的同一个日历日期得到相同的值，可以直接作为 map 键或比较先后
+func dayNumber(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// IsHolidayFast 判断指定日期是否是节假日(休息日)，不返回节日名称和错误
+// 年份已缓存时不产生内存分配；未缓存时会尝试加载，加载失败时与 IsHoliday 一致：
+// 开启 HeuristicFallback 则按周末推断并调用 OnHeuristic，否则 ok 为 false，调用方可以改用 IsHoliday 取得错误
+//
+// 返回值有意采用 (isHoliday, ok) 两值形式而非单个 bool：数据缺失时单个 bool 只能返回 false，
+// 与"确实是工作日"无法区分，调用方会把缺失年份当作工作日处理
+func (c *Checker) IsHolidayFast(date time.Time) (isHoliday, ok bool) {
+	dayType, _, _, err := c.classify(date)
+	if err != nil {
+		return false, false
+	}
+	return !dayType.IsWorkday(), true
+}
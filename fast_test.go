@@ -0,0 +1,186 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // 测试环境可能没有系统时区数据库
+)
+
+func TestDateKey(t *testing.T) {
+	dates := []time.Time{
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(1, 2, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(9999, 10, 9, 0, 0, 0, 0, time.Local),
+	}
+	for _, date := range dates {
+		key := dateKey(date)
+		if got, want := string(key[:]), date.Format("2006-01-02"); got != want {
+			t.Errorf("dateKey(%v) = %q, want %q", date, got, want)
+		}
+	}
+
+	key := dateKey(time.Date(12026, 1, 1, 0, 0, 0, 0, time.UTC))
+	if key != [10]byte{} {
+		t.Errorf("dateKey for year 12026 should be empty, got %q", key[:])
+	}
+}
+
+func TestIsHolidayFast(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	tests := []struct {
+		date     string
+		expected bool
+	}{
+		{"2026-10-01", true},  // 法定节假日
+		{"2026-10-10", false}, // 调休工作日
+		{"2026-10-17", true},  // 周末
+		{"2026-10-12", false}, // 工作日
+	}
+	for _, tt := range tests {
+		date, _ := time.Parse("2006-01-02", tt.date)
+		if got, ok := checker.IsHolidayFast(date); !ok || got != tt.expected {
+			t.Errorf("IsHolidayFast(%s) = %v, %v, want %v", tt.date, got, ok, tt.expected)
+		}
+	}
+
+	// 无法加载数据且未开启 HeuristicFallback 时不作答
+	saturday := time.Date(1990, 1, 6, 0, 0, 0, 0, time.UTC)
+	if _, ok := checker.IsHolidayFast(saturday); ok {
+		t.Error("IsHolidayFast should report ok=false without HeuristicFallback")
+	}
+
+	// 开启后按周末推断，并与 IsHoliday 一样告警
+	var alerts int
+	heuristic := NewCheckerWithConfig(Config{
+		DisableRemote:     true,
+		HeuristicFallback: true,
+		OnHeuristic:       func(time.Time, error) { alerts++ },
+	})
+	if got, ok := heuristic.IsHolidayFast(saturday); !ok || !got || alerts != 1 {
+		t.Errorf("IsHolidayFast(1990-01-06) = %v, %v, alerts = %d; want weekend with one alert", got, ok, alerts)
+	}
+
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	if allocs := testing.AllocsPerRun(100, func() { checker.IsHolidayFast(date) }); allocs != 0 {
+		t.Errorf("IsHolidayFast allocates %v times per call, want 0", allocs)
+	}
+}
+
+func TestGetHolidayInfoInto(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	var info HolidayInfo
+	holiday := time.Date(2026, 10, 7, 0, 0, 0, 0, time.UTC)
+	if err := checker.GetHolidayInfoInto(holiday, &info); err != nil {
+		t.Fatalf("GetHolidayInfoInto failed: %v", err)
+	}
+	want, _ := checker.GetHolidayInfo(holiday)
+	if info != *want {
+		t.Errorf("GetHolidayInfoInto() = %+v, want %+v", info, *want)
+	}
+
+	// 复用时旧字段会被覆盖
+	workday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	if err := checker.GetHolidayInfoInto(workday, &info); err != nil {
+		t.Fatalf("GetHolidayInfoInto failed: %v", err)
+	}
+	if !info.IsWorkday || info.IsHoliday || info.IsInLieuDay || info.HolidayName != "" {
+		t.Errorf("stale fields after reuse: %+v", info)
+	}
+
+	if allocs := testing.AllocsPerRun(100, func() { checker.GetHolidayInfoInto(holiday, &info) }); allocs != 0 {
+		t.Errorf("GetHolidayInfoInto allocates %v times per call, want 0", allocs)
+	}
+}
+
+func TestClassifyAcrossLocations(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	manager := NewTenantManager(checker)
+	manager.SetOverride("acme", time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), Override{IsHoliday: true, Name: "年会"})
+	tenant := manager.ForTenant("acme")
+	pattern := &ShiftPattern{Anchor: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Cycle: []bool{true, true, false}}
+	pattern.SetException(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC), true)
+
+	// 包括美国夏令时切换日(3 月 8 日、11 月 1 日)以及与 UTC 相差超过 12 小时的时区
+	zones := []string{"UTC", "Asia/Shanghai", "America/New_York", "America/Chicago", "America/Los_Angeles", "Pacific/Honolulu", "Pacific/Kiritimati"}
+	for _, zone := range zones {
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			t.Fatalf("LoadLocation(%s) failed: %v", zone, err)
+		}
+		for day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); day.Year() == 2026; day = day.AddDate(0, 0, 1) {
+			wantType, wantName, _ := checker.Classify(day)
+			wantTenant, _, _ := tenant.IsHoliday(day)
+			wantShift, _ := checker.IsShiftWorking(pattern, day)
+			wantNext, _ := checker.NextWorkday(day)
+
+			y, m, d := day.Date()
+			for _, hour := range []int{0, 12, 23} {
+				local := time.Date(y, m, d, hour, 30, 0, 0, loc)
+				key := local.Format("2006-01-02 15:04 MST")
+				if got, name, err := checker.Classify(local); err != nil || got != wantType || name != wantName {
+					t.Fatalf("%s: Classify = %v %q, %v, want %v %q", key, got, name, err, wantType, wantName)
+				}
+				if got, _, _ := tenant.IsHoliday(local); got != wantTenant {
+					t.Fatalf("%s: tenant IsHoliday = %v, want %v", key, got, wantTenant)
+				}
+				if got, _ := checker.IsShiftWorking(pattern, local); got != wantShift {
+					t.Fatalf("%s: IsShiftWorking = %v, want %v", key, got, wantShift)
+				}
+				if wantNext.IsZero() { // 12 月 31 日的下一个工作日在 2027 年，没有数据
+					continue
+				}
+				next, err := checker.NextWorkday(local)
+				if err != nil || dayNumber(next) != dayNumber(wantNext) || next.Location() != loc {
+					t.Fatalf("%s: NextWorkday = %s, %v, want %s", key, next, err, wantNext.Format("2006-01-02"))
+				}
+			}
+		}
+	}
+
+	// 同一时刻在不同时区是不同的日期，按各自时区的日期判断
+	instant := time.Date(2026, 9, 30, 20, 0, 0, 0, time.UTC)
+	shanghai, _ := time.LoadLocation("Asia/Shanghai")
+	if got, _, _ := checker.Classify(instant); got != DayTypeWorkday {
+		t.Errorf("Classify(UTC) = %v, want workday", got)
+	}
+	if got, _, _ := checker.Classify(instant.In(shanghai)); got != DayTypePublicHoliday {
+		t.Errorf("Classify(Asia/Shanghai) = %v, want public holiday", got)
+	}
+}
+
+func TestDateLookupAllocations(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	date := time.Date(2026, 10, 1, 9, 0, 0, 0, newYork)
+
+	manager := NewTenantManager(checker)
+	manager.SetOverride("acme", date, Override{IsHoliday: false, Name: "值班"})
+	tenant := manager.ForTenant("acme")
+	pattern := &ShiftPattern{Anchor: date, Cycle: []bool{true, false}}
+	pattern.SetException(date, true)
+	if _, _, err := checker.Classify(date); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, fn := range map[string]func(){
+		"Classify":       func() { checker.Classify(date) },
+		"TenantHoliday":  func() { tenant.IsHoliday(date) },
+		"IsShiftWorking": func() { checker.IsShiftWorking(pattern, date) },
+	} {
+		if allocs := testing.AllocsPerRun(100, fn); allocs != 0 {
+			t.Errorf("%s allocs = %v, want 0", name, allocs)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package cnholiday
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrFestivalNotFound 年份数据中没有指定节日的放假安排
+var ErrFestivalNotFound = errors.New("年份数据中没有该节日的放假安排")
+
+// Festival 法定节日标识，零值表示不是法定节日
+// 数据中的节日名称可能是 "National Day,国庆节,3"、"国庆节"、"国庆节、中秋节" 等不同写法，
+// 分组和检索时先用 NormalizeFestival 转换为 Festival，再按标识比较
+type Festival uint8
+
+const (
+	FestivalNewYear        Festival = iota + 1 // 元旦
+	FestivalSpringFestival                     // 春节
+	FestivalQingMing                           // 清明
+	FestivalLabourDay                          // 劳动节
+	FestivalDragonBoat                         // 端午
+	FestivalMidAutumn                          // 中秋
+	FestivalNationalDay                        // 国庆节
+)
+
+// festivals 《全国年节及纪念日放假办法》规定的全体公民放假的节日，按时间顺序排列
+var festivals = []Festival{
+	FestivalNewYear, FestivalSpringFestival, FestivalQingMing, FestivalLabourDay,
+	FestivalDragonBoat, FestivalMidAutumn, FestivalNationalDay,
+}
+
+// festivalNames 节日的中文名称，与内置数据文件中的中文名称一致
+var festivalNames = map[Festival]string{
+	FestivalNewYear:        "元旦",
+	FestivalSpringFestival: "春节",
+	FestivalQingMing:       "清明",
+	FestivalLabourDay:      "劳动节",
+	FestivalDragonBoat:     "端午",
+	FestivalMidAutumn:      "中秋",
+	FestivalNationalDay:    "国庆节",
+}
+
+// festivalCodes 节日的稳定编码，用于 JSON、消息和数据库，发布后不得修改
+var festivalCodes = map[Festival]string{
+	FestivalNewYear:        "new_year",
+	FestivalSpringFestival: "spring_festival",
+	FestivalQingMing:       "qingming",
+	FestivalLabourDay:      "labour_day",
+	FestivalDragonBoat:     "dragon_boat",
+	FestivalMidAutumn:      "mid_autumn",
+	FestivalNationalDay:    "national_day",
+}
+
+// festivalAliases 节日名称的各种写法，键为小写形式，需要与名称整体匹配
+var festivalAliases = map[string]Festival{
+	"元旦": FestivalNewYear, "元旦节": FestivalNewYear,
+	"new year's day": FestivalNewYear, "new year": FestivalNewYear, "new years day": FestivalNewYear,
+
+	"春节": FestivalSpringFestival, "农历新年": FestivalSpringFestival,
+	"spring festival": FestivalSpringFestival, "chinese new year": FestivalSpringFestival, "lunar new year": FestivalSpringFestival,
+
+	"清明": FestivalQingMing, "清明节": FestivalQingMing,
+	"tomb-sweeping day": FestivalQingMing, "tomb sweeping day": FestivalQingMing,
+	"qingming": FestivalQingMing, "qingming festival": FestivalQingMing, "ching ming festival": FestivalQingMing,
+
+	"劳动节": FestivalLabourDay, "五一": FestivalLabourDay, "五一劳动节": FestivalLabourDay, "国际劳动节": FestivalLabourDay,
+	"labour day": FestivalLabourDay, "labor day": FestivalLabourDay, "may day": FestivalLabourDay,
+
+	"端午": FestivalDragonBoat, "端午节": FestivalDragonBoat,
+	"dragon boat festival": FestivalDragonBoat, "dragon boat": FestivalDragonBoat, "duanwu festival": FestivalDragonBoat,
+
+	"中秋": FestivalMidAutumn, "中秋节": FestivalMidAutumn,
+	"mid-autumn festival": FestivalMidAutumn, "mid autumn festival": FestivalMidAutumn, "moon festival": FestivalMidAutumn,
+
+	"国庆": FestivalNationalDay, "国庆节": FestivalNationalDay,
+	"national day": FestivalNationalDay, "national day holiday": FestivalNationalDay, "golden week": FestivalNationalDay,
+}
+
+// festivalKeywords 名称整体无法匹配时在中文名称中查找的关键词，用于 "中秋国庆" 这类没有分隔符的写法
+var festivalKeywords = []struct {
+	keyword  string
+	festival Festival
+}{
+	{"元旦", FestivalNewYear},
+	{"春节", FestivalSpringFestival},
+	{"清明", FestivalQingMing},
+	{"劳动节", FestivalLabourDay},
+	{"五一", FestivalLabourDay},
+	{"端午", FestivalDragonBoat},
+	{"中秋", FestivalMidAutumn},
+	{"国庆", FestivalNationalDay},
+}
+
+// String 返回节日的中文名称，例如 "国庆节"
+func (f Festival) String() string {
+	return festivalNames[f]
+}
+
+// Code 返回节日的稳定编码，例如 "national_day"
+func (f Festival) Code() string {
+	return festivalCodes[f]
+}
+
+// ParseFestival 将稳定编码、中英文名称或别名解析为节日标识，例如 "national_day"、"国庆节"、"National Day"
+// 名称包含多个节日或无法识别时返回错误
+func ParseFestival(name string) (Festival, error) {
+	for f, code := range festivalCodes {
+		if code == name {
+			return f, nil
+		}
+	}
+	switch found := NormalizeFestival(name); len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		return 0, fmt.Errorf("未知的节日: %q", name)
+	default:
+		return 0, fmt.Errorf("名称包含多个节日: %q", name)
+	}
+}
+
+// MarshalText 实现 encoding.TextMarshaler，JSON 中以稳定编码表示
+func (f Festival) MarshalText() ([]byte, error) {
+	code, ok := festivalCodes[f]
+	if !ok {
+		return nil, fmt.Errorf("未知的节日: %d", uint8(f))
+	}
+	return []byte(code), nil
+}
+
+// UnmarshalText 实现 encoding.TextUnmarshaler，同样接受中英文名称和别名
+func (f *Festival) UnmarshalText(text []byte) error {
+	parsed, err := ParseFestival(string(text))
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
+
+// NormalizeFestival 将数据中的节日名称转换为节日标识，按时间顺序排列并去重
+// 支持 "English,中文,天数" 格式、中英文别名，以及用 "、"、"/"、"+" 等连接的多个节日，例如：
+//
+//	NormalizeFestival("National Day,国庆节,3") // [FestivalNationalDay]
+//	NormalizeFestival("国庆节、中秋节")          // [FestivalMidAutumn FestivalNationalDay]
+//
+// 无法识别的名称返回 nil
+func NormalizeFestival(name string) []Festival {
+	var found []Festival
+	add := func(f Festival) {
+		if !slices.Contains(found, f) {
+			found = append(found, f)
+		}
+	}
+
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return strings.ContainsRune(",，、/+&;；|", r)
+	})
+	for _, part := range parts {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if f, ok := festivalAliases[part]; ok {
+			add(f)
+			continue
+		}
+		for _, k := range festivalKeywords {
+			if strings.Contains(part, k.keyword) {
+				add(k.festival)
+			}
+		}
+	}
+
+	slices.Sort(found)
+	return found
+}
+
+// Festivals 返回当天所属的法定节日，不属于任何法定节日时返回 nil，
+// 用于代替对 HolidayName 的字符串匹配，例如 slices.Contains(info.Festivals(), FestivalNationalDay)
+func (h *HolidayInfo) Festivals() []Festival {
+	if !h.IsHoliday || h.IsWeekend {
+		return nil
+	}
+	return NormalizeFestival(h.HolidayName)
+}
+
+// FestivalPeriod 一个节日在一次连续放假中的期间(含假期内的周末和补休日)
+type FestivalPeriod struct {
+	Festival Festival  // 节日标识，不是法定节日时为零值
+	Name     string    // 中文名称，法定节日为 Festival.String()，其他为数据中名称的中文部分
+	Start    time.Time // 第一天
+	End      time.Time // 最后一天(包含)
+	Days     int       // 天数
+}
+
+// HolidayBreak 一次连续放假，可能包含多个节日，例如中秋与国庆合并放假
+// 各节日的子区间为数据中标注该节日的第一天到最后一天，数据对每一天都连写多个节日名称时各子区间与整个假期相同
+type HolidayBreak struct {
+	Start     time.Time        // 第一天
+	End       time.Time        // 最后一天(包含)
+	Days      int              // 连续放假天数
+	Festivals []FestivalPeriod // 各节日的子区间，按开始日期排列，开始日期相同时按节日的时间顺序
+}
+
+// Overlapping 本次放假是否包含多个节日
+func (b HolidayBreak) Overlapping() bool {
+	return len(b.Festivals) > 1
+}
+
+// Range 返回本次放假的日期区间
+func (b HolidayBreak) Range() DateRange {
+	return DateRange{Start: b.Start, End: b.End}
+}
+
+// Range 返回节日子区间
+func (p FestivalPeriod) Range() DateRange {
+	return DateRange{Start: p.Start, End: p.End}
+}
+
+// HolidayBreaks 返回 year 年的全部连续放假安排，按开始日期排列
+// 放假日(含补休日)连续的日期归为一次放假，不同节日合并放假时同一次放假中包含多个节日的子区间。
+// 假期按自然年截断，跨年的元旦假期分别出现在两个年份中
+func (c *Checker) HolidayBreaks(year int) ([]HolidayBreak, error) {
+	if _, err := c.index(year); err != nil {
+		return nil, err
+	}
+	return c.holidayBreaks(time.Date(year, 1, 1, 0, 0, 0, 0, time.Local), time.Date(year, 12, 31, 0, 0, 0, 0, time.Local))
+}
+
+// holidayBreaks 将 [start, end] 闭区间内的放假日按连续放假分组，并按节日拆分为子区间
+// 节日的子区间在同一次放假内合并，即使中间夹着其他节日的日期，例如国庆假期中间的中秋
+func (c *Checker) holidayBreaks(start, end time.Time) ([]HolidayBreak, error) {
+	var breaks []HolidayBreak
+	var current *HolidayBreak
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		dayType, name, _, err := c.classify(day)
+		if err != nil {
+			return nil, err
+		}
+		if dayType != DayTypePublicHoliday && dayType != DayTypeInLieu {
+			current = nil
+			continue
+		}
+		if current == nil {
+			breaks = append(breaks, HolidayBreak{Start: day})
+			current = &breaks[len(breaks)-1]
+		}
+		current.End = day
+		current.Days++
+
+		for _, period := range dayFestivals(name) {
+			i := slices.IndexFunc(current.Festivals, func(p FestivalPeriod) bool {
+				return p.Festival == period.Festival && p.Name == period.Name
+			})
+			if i < 0 {
+				period.Start, period.End, period.Days = day, day, 1
+				current.Festivals = append(current.Festivals, period)
+				continue
+			}
+			current.Festivals[i].End = day
+			current.Festivals[i].Days = daysBetween(current.Festivals[i].Start, day) + 1
+		}
+	}
+
+	for _, b := range breaks {
+		slices.SortStableFunc(b.Festivals, func(x, y FestivalPeriod) int {
+			return cmp.Or(x.Start.Compare(y.Start), cmp.Compare(x.Festival, y.Festival))
+		})
+	}
+	return breaks, nil
+}
+
+// dayFestivals 返回名称中包含的节日，无法识别为法定节日时返回名称本身
+func dayFestivals(name string) []FestivalPeriod {
+	found := NormalizeFestival(name)
+	if len(found) == 0 {
+		return []FestivalPeriod{{Name: chineseName(name)}}
+	}
+	periods := make([]FestivalPeriod, len(found))
+	for i, f := range found {
+		periods[i] = FestivalPeriod{Festival: f, Name: f.String()}
+	}
+	return periods
+}
+
+// FestivalPeriods 返回 year 年各法定节日的放假期间，按开始日期排列
+// 指定 filter 时只返回这些节日的放假期间。合并放假时每个节日单独返回自己的子区间，
+// 期间按自然年截断，跨年的元旦假期分别出现在两个年份中
+func (c *Checker) FestivalPeriods(year int, filter ...Festival) ([]FestivalPeriod, error) {
+	for _, f := range filter {
+		if _, ok := festivalNames[f]; !ok {
+			return nil, fmt.Errorf("未知的节日: %d", uint8(f))
+		}
+	}
+	breaks, err := c.HolidayBreaks(year)
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []FestivalPeriod
+	for _, b := range breaks {
+		for _, p := range b.Festivals {
+			if p.Festival != 0 && (len(filter) == 0 || slices.Contains(filter, p.Festival)) {
+				periods = append(periods, p)
+			}
+		}
+	}
+	return periods, nil
+}
+
+// FestivalPeriod 返回 year 年指定节日的第一个放假期间，数据中没有该节日时返回 ErrFestivalNotFound
+func (c *Checker) FestivalPeriod(year int, festival Festival) (*FestivalPeriod, error) {
+	periods, err := c.FestivalPeriods(year, festival)
+	if err != nil {
+		return nil, err
+	}
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("%d 年%s: %w", year, festival, ErrFestivalNotFound)
+	}
+	return &periods[0], nil
+}
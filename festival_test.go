@@ -0,0 +1,227 @@
+package cnholiday
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNormalizeFestival(t *testing.T) {
+	tests := []struct {
+		name string
+		want []Festival
+	}{
+		{"National Day,国庆节,3", []Festival{FestivalNationalDay}},
+		{"国庆节", []Festival{FestivalNationalDay}},
+		{"National Day", []Festival{FestivalNationalDay}},
+		{"国庆节、中秋节", []Festival{FestivalMidAutumn, FestivalNationalDay}},
+		{"中秋国庆", []Festival{FestivalMidAutumn, FestivalNationalDay}},
+		{"Mid-autumn Festival/National Day", []Festival{FestivalMidAutumn, FestivalNationalDay}},
+		{"New Year's Day,元旦,1", []Festival{FestivalNewYear}},
+		{"Chinese New Year", []Festival{FestivalSpringFestival}},
+		{"Tomb-sweeping Day,清明,1", []Festival{FestivalQingMing}},
+		{"五一", []Festival{FestivalLabourDay}},
+		{"端午节", []Festival{FestivalDragonBoat}},
+		{"公司年会", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := NormalizeFestival(tt.name); !slices.Equal(got, tt.want) {
+			t.Errorf("NormalizeFestival(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFestivalString(t *testing.T) {
+	for _, f := range festivals {
+		if got := NormalizeFestival(f.String()); !slices.Equal(got, []Festival{f}) {
+			t.Errorf("NormalizeFestival(%q) = %v, want [%v]", f.String(), got, f)
+		}
+	}
+	if got := Festival(0).String(); got != "" {
+		t.Errorf("Festival(0).String() = %q, want empty", got)
+	}
+}
+
+func TestEventsCanonicalNames(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	// 上游数据对同一节日使用了不同写法，分组时应视为同一个节日
+	jsonData := []byte(`{
+		"holidays": {
+			"2030-10-01": "National Day,国庆节,3",
+			"2030-10-02": "国庆",
+			"2030-10-03": "National Day"
+		}
+	}`)
+	if err := checker.LoadYearFromJSON(2030, jsonData); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	events, err := checker.Events(time.Date(2030, 10, 1, 0, 0, 0, 0, time.Local), time.Date(2030, 10, 3, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("Events failed: %v", err)
+	}
+	if len(events) != 1 || events[0].Name != "国庆节" {
+		t.Errorf("Events = %v, want a single 国庆节 event", events)
+	}
+}
+
+func TestParseFestival(t *testing.T) {
+	for _, name := range []string{"national_day", "国庆节", "国庆", "National Day"} {
+		if f, err := ParseFestival(name); err != nil || f != FestivalNationalDay {
+			t.Errorf("ParseFestival(%q) = %v, %v, want FestivalNationalDay", name, f, err)
+		}
+	}
+	for _, name := range []string{"国庆节、中秋节", "公司年会", ""} {
+		if _, err := ParseFestival(name); err == nil {
+			t.Errorf("ParseFestival(%q) should fail", name)
+		}
+	}
+
+	data, err := json.Marshal(struct{ Festival Festival }{FestivalMidAutumn})
+	if err != nil || string(data) != `{"Festival":"mid_autumn"}` {
+		t.Errorf("json.Marshal = %s, %v", data, err)
+	}
+	var decoded struct{ Festival Festival }
+	if err := json.Unmarshal([]byte(`{"Festival":"dragon_boat"}`), &decoded); err != nil || decoded.Festival != FestivalDragonBoat {
+		t.Errorf("json.Unmarshal = %v, %v", decoded.Festival, err)
+	}
+	if _, err := Festival(0).MarshalText(); err == nil {
+		t.Error("MarshalText of zero Festival should fail")
+	}
+}
+
+func TestFestivalPeriods(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	periods, err := checker.FestivalPeriods(2026)
+	if err != nil {
+		t.Fatalf("FestivalPeriods failed: %v", err)
+	}
+	if len(periods) != len(festivals) {
+		t.Fatalf("len(FestivalPeriods(2026)) = %d, want %d: %v", len(periods), len(festivals), periods)
+	}
+	for i, p := range periods {
+		if p.Festival != festivals[i] {
+			t.Errorf("periods[%d].Festival = %v, want %v", i, p.Festival, festivals[i])
+		}
+	}
+
+	p, err := checker.FestivalPeriod(2026, FestivalSpringFestival)
+	if err != nil {
+		t.Fatalf("FestivalPeriod failed: %v", err)
+	}
+	if p.Start.Format("2006-01-02") != "2026-02-15" || p.End.Format("2006-01-02") != "2026-02-23" || p.Days != 9 {
+		t.Errorf("FestivalPeriod(2026, 春节) = %+v, want 2026-02-15 ~ 2026-02-23, 9 days", p)
+	}
+
+	filtered, err := checker.FestivalPeriods(2026, FestivalNationalDay, FestivalNewYear)
+	if err != nil || len(filtered) != 2 || filtered[0].Festival != FestivalNewYear {
+		t.Errorf("FestivalPeriods(2026, 国庆节, 元旦) = %v, %v", filtered, err)
+	}
+
+	jsonData := []byte(`{"holidays": {"2030-10-01": "国庆节"}}`)
+	if err := checker.LoadYearFromJSON(2030, jsonData); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+	if _, err := checker.FestivalPeriod(2030, FestivalMidAutumn); !errors.Is(err, ErrFestivalNotFound) {
+		t.Errorf("FestivalPeriod(2030, 中秋) error = %v, want ErrFestivalNotFound", err)
+	}
+	if _, err := checker.FestivalPeriods(2026, Festival(99)); err == nil {
+		t.Error("FestivalPeriods with unknown festival should fail")
+	}
+}
+
+func TestHolidayInfoFestivals(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	info, err := checker.GetHolidayInfo(time.Date(2026, 10, 3, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if got := info.Festivals(); !slices.Equal(got, []Festival{FestivalNationalDay}) {
+		t.Errorf("Festivals() = %v, want [国庆节]", got)
+	}
+
+	// 调休工作日的 HolidayName 是对应的节日，但当天不属于放假期间
+	info, err = checker.GetHolidayInfo(time.Date(2026, 10, 10, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if got := info.Festivals(); got != nil {
+		t.Errorf("Festivals() on adjusted workday = %v, want nil", got)
+	}
+}
+
+func TestHolidayBreaksOverlapping(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	breaks, err := checker.HolidayBreaks(2025)
+	if err != nil {
+		t.Fatalf("HolidayBreaks failed: %v", err)
+	}
+
+	// 2025 年中秋(10-06)落在国庆假期中间，国庆节不应被拆成两段
+	var golden *HolidayBreak
+	for i := range breaks {
+		if breaks[i].Start.Format("2006-01-02") == "2025-10-01" {
+			golden = &breaks[i]
+		}
+	}
+	if golden == nil {
+		t.Fatalf("no break starting on 2025-10-01: %v", breaks)
+	}
+	if golden.End.Format("2006-01-02") != "2025-10-08" || golden.Days != 8 || !golden.Overlapping() {
+		t.Errorf("golden week = %+v, want 2025-10-01 ~ 2025-10-08, 8 days, overlapping", golden)
+	}
+	want := []struct {
+		festival   Festival
+		start, end string
+	}{
+		{FestivalNationalDay, "2025-10-01", "2025-10-08"},
+		{FestivalMidAutumn, "2025-10-06", "2025-10-06"},
+	}
+	if len(golden.Festivals) != len(want) {
+		t.Fatalf("Festivals = %+v, want %d entries", golden.Festivals, len(want))
+	}
+	for i, w := range want {
+		p := golden.Festivals[i]
+		if p.Festival != w.festival || p.Start.Format("2006-01-02") != w.start || p.End.Format("2006-01-02") != w.end {
+			t.Errorf("Festivals[%d] = %v %s ~ %s, want %v %s ~ %s", i,
+				p.Festival, p.Start.Format("2006-01-02"), p.End.Format("2006-01-02"), w.festival, w.start, w.end)
+		}
+	}
+
+	periods, err := checker.FestivalPeriods(2025, FestivalNationalDay)
+	if err != nil || len(periods) != 1 || periods[0].Days != 8 {
+		t.Errorf("FestivalPeriods(2025, 国庆节) = %+v, %v, want one 8-day period", periods, err)
+	}
+}
+
+func TestHolidayBreaksConcatenatedNames(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	// 上游数据把合并放假的节日连写在同一个名称中
+	jsonData := []byte(`{
+		"holidays": {
+			"2030-09-29": "中秋节、国庆节",
+			"2030-09-30": "中秋节、国庆节",
+			"2030-10-01": "中秋节、国庆节",
+			"2030-10-02": "国庆节"
+		}
+	}`)
+	if err := checker.LoadYearFromJSON(2030, jsonData); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	events, err := checker.Events(time.Date(2030, 9, 1, 0, 0, 0, 0, time.Local), time.Date(2030, 10, 31, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("Events failed: %v", err)
+	}
+	var got []string
+	for _, e := range events {
+		got = append(got, e.Name+" "+e.Start.Format("01-02")+"~"+e.End.Format("01-02"))
+	}
+	want := []string{"中秋 09-29~10-01", "国庆节 09-29~10-02"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Events = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,95 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func FuzzLoadYearFromJSON(f *testing.F) {
+	f.Add(2026, []byte(`{"holidays":{"2026-10-01":"国庆节"},"workdays":{"2026-10-10":"国庆节"},"inLieuDays":{}}`))
+	f.Add(2030, []byte(`{"holidays":{},"workdays":{}}`))
+	f.Add(-1, []byte(`{"holidays":{"-001-01-01":"x"}}`))
+	f.Add(1<<40, []byte(`[]`))
+	f.Add(2026, []byte(`{"holidays":null,"workdays":{"2026-02-30":""},"extra":1}`))
+
+	f.Fuzz(func(t *testing.T, year int, data []byte) {
+		for _, strict := range []bool{false, true} {
+			checker := NewCheckerWithConfig(Config{DisableRemote: true, StrictValidation: strict})
+			if err := checker.LoadYearFromJSON(year, data); err != nil {
+				continue
+			}
+			if strict && ValidateYear(year, data) != nil {
+				t.Fatal("strict checker accepted data rejected by ValidateYear")
+			}
+			// 加载成功后查询该年份的任意一天都不应出错
+			if year >= 1 && year <= 9999 {
+				date := time.Date(year, 6, 1, 0, 0, 0, 0, time.UTC)
+				if _, err := checker.IsWorkday(date); err != nil {
+					t.Fatalf("IsWorkday(%v) after load: %v", date, err)
+				}
+			}
+		}
+	})
+}
+
+func FuzzValidateSchema(f *testing.F) {
+	f.Add([]byte(`{"holidays":{"2026-10-01":"国庆节"},"workdays":{}}`))
+	f.Add([]byte(`{"holidays":{"2026-13-01":1},"workdays":[]}`))
+	f.Add([]byte(`null`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		err := ValidateSchema(data)
+		if err != nil {
+			if _, ok := err.(*ValidationError); !ok {
+				t.Fatalf("ValidateSchema returned %T, want *ValidationError", err)
+			}
+		}
+	})
+}
+
+func FuzzWorkdayMath(f *testing.F) {
+	f.Add(int64(0), 10)
+	f.Add(int64(364), -10)
+	f.Add(int64(-30), 1<<62)
+	f.Add(int64(700), -1<<63)
+
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	for _, year := range []int{2025, 2026} {
+		if err := checker.LoadYear(year); err != nil {
+			f.Fatalf("Setup failed: %v", err)
+		}
+	}
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	f.Fuzz(func(t *testing.T, offset int64, n int) {
+		if offset < -1000 || offset > 2000 {
+			return
+		}
+		date := base.AddDate(0, 0, int(offset))
+
+		result, err := checker.AddWorkdays(date, n)
+		if err != nil {
+			return
+		}
+		switch {
+		case n > 0 && !result.After(date), n < 0 && !result.Before(date), n == 0 && !result.Equal(date):
+			t.Fatalf("AddWorkdays(%v, %d) = %v moved in the wrong direction", date, n, result)
+		}
+		if n == 0 {
+			return
+		}
+
+		// AddWorkdays 与 CountWorkdaysBetween 互为逆运算
+		start, end := date.AddDate(0, 0, 1), result
+		if n < 0 {
+			start, end = result, date.AddDate(0, 0, -1)
+		}
+		count, err := checker.CountWorkdaysBetween(start, end)
+		if err != nil {
+			t.Fatalf("CountWorkdaysBetween(%v, %v): %v", start, end, err)
+		}
+		if want := max(n, -n); count != want {
+			t.Fatalf("CountWorkdaysBetween(%v, %v) = %d, want %d", start, end, count, want)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package cnholiday
+
+import (
+	"context"
+	"time"
+)
+
+// 以下函数均使用默认检查器，方便脚本式调用，可通过 SetDefaultChecker 替换默认检查器
+
+// LoadYear 使用默认检查器加载指定年份的数据
+func LoadYear(year int) error {
+	return DefaultChecker().LoadYear(year)
+}
+
+// Preload 使用默认检查器并发预加载多个年份的数据
+func Preload(ctx context.Context, years ...int) error {
+	return DefaultChecker().LoadYears(ctx, years...)
+}
+
+// IsHolidayFast 使用默认检查器快速判断是否是节假日
+func IsHolidayFast(date time.Time) (isHoliday, ok bool) {
+	return DefaultChecker().IsHolidayFast(date)
+}
+
+// Classify 使用默认检查器返回日期类型
+func Classify(date time.Time) (DayType, string, error) {
+	return DefaultChecker().Classify(date)
+}
+
+// IsWeekend 使用默认检查器判断是否是普通周末
+func IsWeekend(date time.Time) (bool, error) {
+	return DefaultChecker().IsWeekend(date)
+}
+
+// IsAdjustedWorkday 使用默认检查器判断是否是调休工作日
+func IsAdjustedWorkday(date time.Time) (bool, error) {
+	return DefaultChecker().IsAdjustedWorkday(date)
+}
+
+// IsInLieuDay 使用默认检查器判断是否是补休日
+func IsInLieuDay(date time.Time) (bool, error) {
+	return DefaultChecker().IsInLieuDay(date)
+}
+
+// NextWorkday 使用默认检查器返回下一个工作日
+func NextWorkday(date time.Time) (time.Time, error) {
+	return DefaultChecker().NextWorkday(date)
+}
+
+// PrevWorkday 使用默认检查器返回上一个工作日
+func PrevWorkday(date time.Time) (time.Time, error) {
+	return DefaultChecker().PrevWorkday(date)
+}
+
+// NextHoliday 使用默认检查器返回下一个放假日
+func NextHoliday(date time.Time) (*HolidayInfo, error) {
+	return DefaultChecker().NextHoliday(date)
+}
+
+// PrevHoliday 使用默认检查器返回上一个放假日
+func PrevHoliday(date time.Time) (*HolidayInfo, error) {
+	return DefaultChecker().PrevHoliday(date)
+}
+
+// DayContext 使用默认检查器返回日期信息及其上下文
+func DayContext(date time.Time) (*DayContextInfo, error) {
+	return DefaultChecker().DayContext(date)
+}
+
+// CountWorkdaysBetween 使用默认检查器统计闭区间内的工作日天数
+func CountWorkdaysBetween(start, end time.Time) (int, error) {
+	return DefaultChecker().CountWorkdaysBetween(start, end)
+}
+
+// WorkdayFraction 使用默认检查器计算子区间的工作日占比
+func WorkdayFraction(periodStart, periodEnd, subStart, subEnd time.Time) (float64, error) {
+	return DefaultChecker().WorkdayFraction(periodStart, periodEnd, subStart, subEnd)
+}
+
+// ListHolidays 使用默认检查器列出指定年份的放假日期
+func ListHolidays(year int) ([]HolidayInfo, error) {
+	return DefaultChecker().ListHolidays(year)
+}
+
+// AdjustedWorkdays 使用默认检查器列出指定年份的调休工作日
+func AdjustedWorkdays(year int) ([]HolidayInfo, error) {
+	return DefaultChecker().AdjustedWorkdays(year)
+}
+
+// InLieuDays 使用默认检查器列出指定年份的补休日
+func InLieuDays(year int) ([]HolidayInfo, error) {
+	return DefaultChecker().InLieuDays(year)
+}
+
+// YearInfo 使用默认检查器返回指定年份每一天的节假日信息
+func YearInfo(year int) ([]HolidayInfo, error) {
+	return DefaultChecker().YearInfo(year)
+}
+
+// SplitByDayType 使用默认检查器按日期类型切分区间
+func SplitByDayType(start, end time.Time) ([]DayTypeRun, error) {
+	return DefaultChecker().SplitByDayType(start, end)
+}
+
+// ExpectedAttendance 使用默认检查器统计应出勤天数
+func ExpectedAttendance(start, end time.Time, policy AttendancePolicy) (*Attendance, error) {
+	return DefaultChecker().ExpectedAttendance(start, end, policy)
+}
+
+// OvertimeBreakdown 使用默认检查器对工时进行分类
+func OvertimeBreakdown(entries []WorkEntry) (*Overtime, error) {
+	return DefaultChecker().OvertimeBreakdown(entries)
+}
+
+// CalcLeaveSpan 使用默认检查器计算假期的实际区间
+func CalcLeaveSpan(start time.Time, days int, leave LeaveType) (*LeaveSpan, error) {
+	return DefaultChecker().LeaveSpan(start, days, leave)
+}
+
+// WorkdaysInWeek 使用默认检查器返回 anchor 所在 ISO 周的工作日天数
+func WorkdaysInWeek(anchor time.Time) (int, error) {
+	return DefaultChecker().WorkdaysInWeek(anchor)
+}
+
+// AddWorkdays 使用默认检查器返回 date 之后第 n 个工作日
+func AddWorkdays(date time.Time, n int) (time.Time, error) {
+	return DefaultChecker().AddWorkdays(date, n)
+}
+
+// WorkdaysBefore 使用默认检查器返回截止日期之前第 n 个工作日
+func WorkdaysBefore(deadline time.Time, n int) (time.Time, error) {
+	return DefaultChecker().WorkdaysBefore(deadline, n)
+}
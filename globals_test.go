@@ -0,0 +1,45 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetDefaultChecker(t *testing.T) {
+	original := DefaultChecker()
+	defer SetDefaultChecker(original)
+
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	SetDefaultChecker(checker)
+	if DefaultChecker() != checker {
+		t.Fatal("SetDefaultChecker did not replace the default checker")
+	}
+
+	SetDefaultChecker(nil)
+	if DefaultChecker() != checker {
+		t.Error("SetDefaultChecker(nil) should be ignored")
+	}
+
+	if err := LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if !checker.IsYearLoaded(2026) {
+		t.Error("LoadYear should load into the default checker")
+	}
+
+	next, err := NextWorkday(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("NextWorkday failed: %v", err)
+	}
+	if got := next.Format("2006-01-02"); got != "2026-10-08" {
+		t.Errorf("NextWorkday() = %s, want 2026-10-08", got)
+	}
+
+	count, err := CountWorkdaysBetween(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("CountWorkdaysBetween failed: %v", err)
+	}
+	if count != 18 {
+		t.Errorf("CountWorkdaysBetween() = %d, want 18", count)
+	}
+}
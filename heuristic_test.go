@@ -0,0 +1,53 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHeuristicFallback(t *testing.T) {
+	var warned []time.Time
+	checker := NewCheckerWithConfig(Config{
+		DisableRemote:     true,
+		HeuristicFallback: true,
+		OnHeuristic: func(date time.Time, err error) {
+			if err == nil {
+				t.Error("OnHeuristic called without error")
+			}
+			warned = append(warned, date)
+		},
+	})
+
+	saturday := time.Date(1990, 1, 6, 0, 0, 0, 0, time.UTC)
+	info, err := checker.GetHolidayInfo(saturday)
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if !info.Heuristic || !info.IsWeekend {
+		t.Errorf("info = %+v, want heuristic weekend", info)
+	}
+
+	isWorkday, err := checker.IsWorkday(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil || !isWorkday {
+		t.Errorf("IsWorkday() = %v, %v; want true, nil", isWorkday, err)
+	}
+	if len(warned) != 2 {
+		t.Errorf("OnHeuristic called %d times, want 2", len(warned))
+	}
+	if got := checker.Counters().Heuristic; got != 2 {
+		t.Errorf("Counters().Heuristic = %d, want 2", got)
+	}
+
+	// 有数据时给出权威结果
+	info, err = checker.GetHolidayInfo(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil || info.Heuristic || !info.IsHoliday {
+		t.Errorf("info = %+v, %v; want authoritative holiday", info, err)
+	}
+}
+
+func TestHeuristicFallbackDisabled(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if _, err := checker.GetHolidayInfo(time.Date(1990, 1, 6, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error without HeuristicFallback")
+	}
+}
@@ -0,0 +1,59 @@
+package cnholiday
+
+import (
+	"time"
+)
+
+// Amendment 年份数据的一次变更记录
+type Amendment struct {
+	Seq     int         // 该年份内从 1 开始递增的序号
+	Time    time.Time   // 变更生效时间
+	Source  Source      // 变更来源
+	Changes []DayChange // 相对上一次变更的差异，首次加载时相对仅有周末的空白日历
+
+	index *yearIndex // 变更后的完整数据快照
+}
+
+// History 返回指定年份按时间顺序排列的变更记录
+// 记录只追加不丢弃，内容没有变化的重新加载不产生记录；相邻年份数据中跨年的调休工作日变化时，
+// 该年份也会追加一条记录。ClearYear 和 ClearCache 不会清除历史
+func (c *Checker) History(year int) []Amendment {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	history := c.history[year]
+	copied := make([]Amendment, len(history))
+	for i, a := range history {
+		copied[i] = a
+		copied[i].Changes = append([]DayChange(nil), a.Changes...)
+	}
+	return copied
+}
+
+// appendHistory 追加一条变更记录并返回该记录，调用方需持有写锁
+// 与上一条记录相比内容没有变化时不追加，after 沿用上一条记录的版本号，返回的记录 Changes 为空
+func (c *Checker) appendHistory(year int, source Source, after *yearIndex, at time.Time) Amendment {
+	history := c.history[year]
+
+	before, seq := newYearIndex(year, &HolidayData{}), 0
+	if n := len(history); n > 0 {
+		before, seq = history[n-1].index, history[n-1].Seq
+	}
+
+	changes := diffYearIndex(before, after)
+	if seq > 0 && len(changes) == 0 {
+		after.source, after.version = source, seq
+		return Amendment{Seq: seq, Time: at, Source: source, index: after}
+	}
+
+	after.source, after.version = source, seq+1
+	amendment := Amendment{
+		Seq:     seq + 1,
+		Time:    at,
+		Source:  source,
+		Changes: changes,
+		index:   after,
+	}
+	c.history[year] = append(history, amendment)
+	return amendment
+}
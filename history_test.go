@@ -0,0 +1,131 @@
+package cnholiday
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestHistory(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	if got := checker.History(2026); len(got) != 0 {
+		t.Fatalf("History before load = %v, want empty", got)
+	}
+
+	jsonData := []byte(`{
+		"holidays": {"2026-01-01": "元旦", "2026-01-02": "元旦"},
+		"workdays": {"2026-01-04": "元旦"},
+		"inLieuDays": {}
+	}`)
+	if err := checker.LoadYearFromJSON(2026, jsonData); err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+	if _, err := checker.PatchYear(2026, &HolidayData{Holidays: map[string]string{"2026-01-02": ""}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+
+	// 清除缓存后重新加载，历史保留
+	checker.ClearYear(2026)
+	if err := checker.LoadYearFromJSON(2026, jsonData); err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+
+	history := checker.History(2026)
+	if len(history) != 3 {
+		t.Fatalf("len(History) = %d, want 3", len(history))
+	}
+
+	// 首次加载：1 日、2 日为节假日，4 日(周日)为调休工作日
+	if history[0].Seq != 1 || history[0].Source != SourceJSON || len(history[0].Changes) != 3 {
+		t.Errorf("history[0] = %+v", history[0])
+	}
+	if history[1].Source != SourcePatch || len(history[1].Changes) != 1 || history[1].Changes[0].After != DayTypeWorkday {
+		t.Errorf("history[1] = %+v", history[1])
+	}
+	// 第三次加载恢复了 2 日的节假日
+	if history[2].Seq != 3 || len(history[2].Changes) != 1 || history[2].Changes[0].After != DayTypePublicHoliday {
+		t.Errorf("history[2] = %+v", history[2])
+	}
+	for i := 1; i < len(history); i++ {
+		if history[i].Time.Before(history[i-1].Time) {
+			t.Errorf("history not in time order at %d", i)
+		}
+	}
+
+	// 返回值是副本
+	history[0].Changes[0].AfterName = "modified"
+	if checker.History(2026)[0].Changes[0].AfterName == "modified" {
+		t.Error("History should return a copy")
+	}
+}
+
+func TestHistoryUnchangedReload(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	date := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+
+	for range 100 {
+		checker.ClearYear(2026)
+		if err := checker.LoadYear(2026); err != nil {
+			t.Fatalf("LoadYear failed: %v", err)
+		}
+	}
+	if history := checker.History(2026); len(history) != 1 {
+		t.Errorf("len(History) = %d, want 1", len(history))
+	}
+	if info, err := checker.GetHolidayInfo(date); err != nil || info.DataVersion != 1 {
+		t.Errorf("DataVersion = %+v, %v, want 1", info, err)
+	}
+
+	// 每次修订都有变化，记录全部保留
+	for i := range 100 {
+		name := fmt.Sprintf("修订%d", i)
+		if _, err := checker.PatchYear(2026, &HolidayData{Holidays: map[string]string{"2026-10-01": name}}); err != nil {
+			t.Fatalf("PatchYear failed: %v", err)
+		}
+	}
+	history := checker.History(2026)
+	if len(history) != 101 || history[0].Seq != 1 || history[len(history)-1].Seq != 101 {
+		t.Errorf("len(History) = %d, seq %d ~ %d, want 101 entries", len(history), history[0].Seq, history[len(history)-1].Seq)
+	}
+	if info, _ := checker.GetHolidayInfo(date); info.DataVersion != 101 {
+		t.Errorf("DataVersion = %d, want 101", info.DataVersion)
+	}
+}
+
+func TestHistoryCrossYear(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYears(context.Background(), 2025, 2026); err != nil {
+		t.Fatalf("LoadYears failed: %v", err)
+	}
+	var events []ChangeEvent
+	checker.Subscribe(func(e ChangeEvent) { events = append(events, e) })
+
+	// 2026 年数据中记录 2025 年 12 月的调休工作日，2025 年追加一条变更记录
+	spill := time.Date(2025, 12, 27, 0, 0, 0, 0, time.Local)
+	if _, err := checker.PatchYear(2026, &HolidayData{Workdays: map[string]string{"2025-12-27": "元旦"}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	history := checker.History(2025)
+	last := history[len(history)-1]
+	if len(history) != 2 || last.Source != SourcePatch || len(last.Changes) != 1 || last.Changes[0].Date.Format(time.DateOnly) != "2025-12-27" {
+		t.Fatalf("History(2025) = %+v", history)
+	}
+	if dayType, _, err := checker.AsOf(last.Time).Classify(spill); err != nil || dayType != DayTypeAdjustedWorkday {
+		t.Errorf("AsOf.Classify = %v, %v, want adjusted workday", dayType, err)
+	}
+	// 2026 年本身的日期没有变化，只通知 2025 年
+	if len(events) != 1 || events[0].Year != 2025 {
+		t.Errorf("events = %+v", events)
+	}
+
+	// 重新加载 2025 年时跨年调休不被当作数据源的变化
+	checker.ClearYear(2025)
+	if err := checker.LoadYear(2025); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if n := len(checker.History(2025)); n != 2 {
+		t.Errorf("len(History(2025)) after reload = %d, want 2", n)
+	}
+}
@@ -0,0 +1,98 @@
+package cnholiday
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// icsEscaper 转义 iCalendar 文本中的特殊字符
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// WriteICS 将指定年份的放假安排导出为 iCalendar(RFC 5545) 格式，可导入日历应用订阅
+// 每段连续假期导出为一个全天事件，合并放假的多个节日写在同一个事件中，每个调休工作日单独导出为一个"上班"事件
+func (c *Checker) WriteICS(w io.Writer, year int) error {
+	if _, err := c.index(year); err != nil {
+		return err
+	}
+	return c.WriteICSIn(w, YearRange(year))
+}
+
+// WriteICSIn 将区间内的放假安排导出为 iCalendar 格式，事件的划分与 WriteICS 相同，
+// 与区间边界相交的假期会被截断。区间恰好包含一个自然年时输出与 WriteICS 相同
+func (c *Checker) WriteICSIn(w io.Writer, r DateRange) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	// 事件日期按 UTC 零点计算，只取区间的年月日
+	first, last := utcDay(r.first()), utcDay(r.last())
+
+	var b strings.Builder
+	b.WriteString("BEGIN:VCALENDAR\r\n")
+	b.WriteString("VERSION:2.0\r\n")
+	b.WriteString("PRODID:-//luojiego//cnholiday//CN\r\n")
+	b.WriteString("CALSCALE:GREGORIAN\r\n")
+	if first.YearDay() == 1 && last.Equal(time.Date(first.Year(), 12, 31, 0, 0, 0, 0, time.UTC)) {
+		fmt.Fprintf(&b, "X-WR-CALNAME:%d年节假日\r\n", first.Year())
+	} else {
+		fmt.Fprintf(&b, "X-WR-CALNAME:%s 节假日\r\n", r)
+	}
+
+	// DTSTAMP 取区间第一天而不是当前时间，同一份数据多次导出的结果逐字节相同
+	stamp := first.Format("20060102T150405Z")
+	writeEvent := func(start, end time.Time, summary string) {
+		b.WriteString("BEGIN:VEVENT\r\n")
+		fmt.Fprintf(&b, "UID:%s-%s@cnholiday\r\n", start.Format("20060102"), end.Format("20060102"))
+		fmt.Fprintf(&b, "DTSTAMP:%s\r\n", stamp)
+		fmt.Fprintf(&b, "DTSTART;VALUE=DATE:%s\r\n", start.Format("20060102"))
+		fmt.Fprintf(&b, "DTEND;VALUE=DATE:%s\r\n", end.Format("20060102"))
+		fmt.Fprintf(&b, "SUMMARY:%s\r\n", icsEscaper.Replace(summary))
+		b.WriteString("TRANSP:TRANSPARENT\r\n")
+		b.WriteString("END:VEVENT\r\n")
+	}
+
+	breaks, err := c.holidayBreaks(r.first(), r.last())
+	if err != nil {
+		return err
+	}
+	writeBreak := func(hb HolidayBreak) {
+		names := make([]string, len(hb.Festivals))
+		for i, p := range hb.Festivals {
+			names[i] = p.Name
+		}
+		start := utcDay(hb.Start)
+		writeEvent(start, start.AddDate(0, 0, hb.Days), strings.Join(names, "、")+" 放假")
+	}
+
+	// 假期事件在假期结束后的第一天写出，与调休上班事件按日期交错排列
+	var idx *yearIndex
+	next := 0
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if next < len(breaks) && utcDay(breaks[next].End).Before(day) {
+			writeBreak(breaks[next])
+			next++
+		}
+		if idx == nil || idx.year != day.Year() {
+			if idx, err = c.index(day.Year()); err != nil {
+				return err
+			}
+		}
+		if dayType, name := idx.lookup(day); dayType == DayTypeAdjustedWorkday {
+			writeEvent(day, day.AddDate(0, 0, 1), chineseName(name)+" 调休上班")
+		}
+	}
+	for ; next < len(breaks); next++ {
+		writeBreak(breaks[next])
+	}
+
+	b.WriteString("END:VCALENDAR\r\n")
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// utcDay 返回 t 所在日期的 UTC 零点
+func utcDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,88 @@
+package cnholiday
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteICS(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var b strings.Builder
+	if err := checker.WriteICS(&b, 2026); err != nil {
+		t.Fatalf("WriteICS failed: %v", err)
+	}
+	out := b.String()
+
+	if !strings.HasPrefix(out, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+		t.Error("output is not a VCALENDAR")
+	}
+	if strings.Count(out, "BEGIN:VEVENT") != strings.Count(out, "END:VEVENT") {
+		t.Error("unbalanced VEVENT blocks")
+	}
+
+	for _, want := range []string{
+		"DTSTART;VALUE=DATE:20261001\r\nDTEND;VALUE=DATE:20261008\r\nSUMMARY:国庆节 放假\r\n",
+		"DTSTART;VALUE=DATE:20261010\r\nDTEND;VALUE=DATE:20261011\r\nSUMMARY:国庆节 调休上班\r\n",
+		"DTSTART;VALUE=DATE:20260215\r\nDTEND;VALUE=DATE:20260224\r\nSUMMARY:春节 放假\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q", want)
+		}
+	}
+
+	if err := checker.WriteICS(&b, 1990); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
+
+func TestWriteICSOverlappingFestivals(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var b strings.Builder
+	if err := checker.WriteICS(&b, 2025); err != nil {
+		t.Fatalf("WriteICS failed: %v", err)
+	}
+	// 2025 年中秋落在国庆假期中间，整个假期导出为一个事件
+	want := "DTSTART;VALUE=DATE:20251001\r\nDTEND;VALUE=DATE:20251009\r\nSUMMARY:国庆节、中秋 放假\r\n"
+	if out := b.String(); !strings.Contains(out, want) {
+		t.Errorf("output missing %q", want)
+	}
+}
+
+func TestWriteICSIn(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	// 整年区间与 WriteICS 逐字节相同
+	var year, in strings.Builder
+	if err := checker.WriteICS(&year, 2026); err != nil {
+		t.Fatalf("WriteICS failed: %v", err)
+	}
+	if err := checker.WriteICSIn(&in, YearRange(2026)); err != nil {
+		t.Fatalf("WriteICSIn failed: %v", err)
+	}
+	if in.String() != year.String() {
+		t.Error("WriteICSIn(YearRange) differs from WriteICS")
+	}
+
+	// 与区间边界相交的假期被截断
+	var b strings.Builder
+	r := DateRange{Start: time.Date(2026, 10, 3, 0, 0, 0, 0, time.Local), End: time.Date(2026, 10, 10, 0, 0, 0, 0, time.Local)}
+	if err := checker.WriteICSIn(&b, r); err != nil {
+		t.Fatalf("WriteICSIn failed: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"X-WR-CALNAME:2026-10-03 ~ 2026-10-10 节假日\r\n",
+		"DTSTART;VALUE=DATE:20261003\r\nDTEND;VALUE=DATE:20261008\r\nSUMMARY:国庆节 放假\r\n",
+		"DTSTART;VALUE=DATE:20261010\r\nDTEND;VALUE=DATE:20261011\r\nSUMMARY:国庆节 调休上班\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q", want)
+		}
+	}
+	if n := strings.Count(out, "BEGIN:VEVENT"); n != 2 {
+		t.Errorf("got %d events, want 2", n)
+	}
+}
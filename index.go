@@ -0,0 +1,254 @@
+package cnholiday
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// yearIndex 按年内序号(YearDay-1)索引的日期类型表
+// 加载时由 HolidayData 一次性构建，查询时只需数组下标访问，不再拼接字符串查 map。
+// 节日名称经过驻留，每年只保存一份去重后的名称表；另按序号升序保存放假日和工作日，
+// 供查找上一个/下一个放假日或工作日时二分查找。结构体约 1.2KB，加上序号表和名称表每个年份约 2KB，
+// 不含 base 引用的年份数据
+type yearIndex struct {
+	year   int
+	types  [366]DayType
+	names  [366]uint16 // names 表中的下标，0 表示无名称
+	split  uint16      // sorted 中放假日序号的个数
+	table  []string    // 去重后的名称表，table[0] 为空字符串
+	sorted []uint16    // 前 split 个为放假日(法定节假日和补休日，不含普通周末)的序号，其后为工作日(含调休工作日)的序号，各自升序
+
+	source  Source    // 数据来源，写入缓存时设置
+	version int       // 对应变更记录的序号，写入缓存时设置
+	base    *yearData // 构建索引的本年份数据，不含合并进来的相邻年份调休工作日
+}
+
+// yearData 缓存中保存的年份数据，字段不导出。
+// 写入缓存时从调用方的 HolidayData 深拷贝而来，对外只通过 yearIndex.holidayData 返回重建的副本，
+// 缓存内部的 map 不会被调用方持有，也就不会在查询期间被修改
+type yearData struct {
+	holidays   map[string]string
+	workdays   map[string]string
+	inLieuDays map[string]string
+}
+
+// newYearData 深拷贝 data 作为缓存中保存的年份数据
+func newYearData(data *HolidayData) *yearData {
+	clone := cloneHolidayData(data)
+	return &yearData{holidays: clone.Holidays, workdays: clone.Workdays, inLieuDays: clone.InLieuDays}
+}
+
+// view 返回共享底层 map 的只读视图，仅供包内构建索引等不会修改数据的场景使用
+func (d *yearData) view() *HolidayData {
+	return &HolidayData{Holidays: d.holidays, Workdays: d.workdays, InLieuDays: d.inLieuDays}
+}
+
+// newYearIndex 根据年份数据构建索引，数据中不属于该年份的日期会被忽略
+func newYearIndex(year int, data *HolidayData) *yearIndex {
+	idx := &yearIndex{year: year, table: []string{""}}
+	interned := map[string]uint16{"": 0}
+	intern := func(name string) uint16 {
+		if i, ok := interned[name]; ok {
+			return i
+		}
+		i := uint16(len(idx.table))
+		idx.table = append(idx.table, name)
+		interned[name] = i
+		return i
+	}
+
+	day := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; day.Year() == year; i++ {
+		key := dateKey(day)
+		k := string(key[:])
+
+		if name, exists := data.Workdays[k]; exists {
+			idx.types[i], idx.names[i] = DayTypeAdjustedWorkday, intern(name)
+		} else if name, exists := data.Holidays[k]; exists {
+			idx.types[i], idx.names[i] = DayTypePublicHoliday, intern(name)
+			if _, isInLieu := data.InLieuDays[k]; isInLieu {
+				idx.types[i] = DayTypeInLieu
+			}
+		} else if name, exists := data.InLieuDays[k]; exists {
+			// 补休日本身就是放假日，只出现在 inLieuDays 中时同样按补休日处理
+			idx.types[i], idx.names[i] = DayTypeInLieu, intern(name)
+		} else if weekday := day.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			idx.types[i] = DayTypeWeekend
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	// 放假日与工作日的序号共用一个切片，放假日在前
+	days := day.AddDate(0, 0, -1).YearDay()
+	idx.sorted = make([]uint16, 0, days)
+	for i, t := range idx.types[:days] {
+		if t == DayTypePublicHoliday || t == DayTypeInLieu {
+			idx.sorted = append(idx.sorted, uint16(i))
+		}
+	}
+	idx.split = uint16(len(idx.sorted))
+	for i, t := range idx.types[:days] {
+		if t.IsWorkday() {
+			idx.sorted = append(idx.sorted, uint16(i))
+		}
+	}
+	return idx
+}
+
+// offDays 返回放假日的序号，升序
+func (idx *yearIndex) offDays() []uint16 {
+	return idx.sorted[:idx.split]
+}
+
+// workdays 返回工作日的序号，升序
+func (idx *yearIndex) workdays() []uint16 {
+	return idx.sorted[idx.split:]
+}
+
+// search 在升序的序号表 days 中沿 step 方向查找第一个不早于(step 为负数时不晚于) from 的序号
+func search(days []uint16, from, step int) (int, bool) {
+	i, found := slices.BinarySearch(days, uint16(from))
+	if step > 0 {
+		if i < len(days) {
+			return int(days[i]), true
+		}
+		return 0, false
+	}
+	if !found {
+		i--
+	}
+	if i >= 0 {
+		return int(days[i]), true
+	}
+	return 0, false
+}
+
+// lookup 返回指定日期的类型及节日名称，date 必须属于索引对应的年份
+func (idx *yearIndex) lookup(date time.Time) (DayType, string) {
+	i := date.YearDay() - 1
+	return idx.types[i], idx.table[idx.names[i]]
+}
+
+// holidayData 由索引还原出年份数据，每次调用返回新的副本
+// 补休日总是同时写入 Holidays 和 InLieuDays，只出现在 inLieuDays 中的日期还原后也会出现在 Holidays 中
+func (idx *yearIndex) holidayData() *HolidayData {
+	data := &HolidayData{
+		Holidays:   make(map[string]string),
+		Workdays:   make(map[string]string),
+		InLieuDays: make(map[string]string),
+	}
+
+	day := time.Date(idx.year, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; day.Year() == idx.year; i++ {
+		key := dateKey(day)
+		name := idx.table[idx.names[i]]
+		switch idx.types[i] {
+		case DayTypeAdjustedWorkday:
+			data.Workdays[string(key[:])] = name
+		case DayTypeInLieu:
+			data.InLieuDays[string(key[:])] = name
+			data.Holidays[string(key[:])] = name
+		case DayTypePublicHoliday:
+			data.Holidays[string(key[:])] = name
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return data
+}
+
+// index 返回指定年份的索引，未加载时自动加载
+func (c *Checker) index(year int) (*yearIndex, error) {
+	return c.indexContext(context.Background(), year)
+}
+
+// indexContext 与 index 相同，在 ctx 的控制下加载，参见 EnsureYearLoaded
+func (c *Checker) indexContext(ctx context.Context, year int) (*yearIndex, error) {
+	c.mu.RLock()
+	idx := c.cache[year]
+	c.mu.RUnlock()
+	if idx != nil {
+		c.counters.cacheHits.Add(1)
+		return idx, nil
+	}
+
+	c.counters.cacheMisses.Add(1)
+	idx, err := c.ensureYearLoaded(ctx, year)
+	if err != nil {
+		c.counters.queryErrors.Add(1)
+		return nil, fmt.Errorf("加载 %d 年数据失败: %w", year, err)
+	}
+	return idx, nil
+}
+
+// yearLoad 一次进行中的按需加载
+// 加载结束后 idx、err 可读；之后即使缓存被清除，等待的调用方仍使用 idx 作答
+type yearLoad struct {
+	done    chan struct{} // 加载结束后关闭
+	idx     *yearIndex    // 加载写入缓存的索引
+	err     error         // 加载失败的原因
+	waiters int           // 仍在等待的调用方，由 loadMu 保护
+	cancel  context.CancelFunc
+}
+
+// EnsureYearLoaded 确保年份数据已缓存，未缓存时在 ctx 的控制下加载，已缓存时不重新加载
+// 同一年份的并发调用(包括查询方法触发的自动加载)共享一次加载。ctx 取消时立即返回 ctx 的错误，
+// 其他调用方仍在等待时加载继续进行；全部调用方都取消后加载才中止，中止的加载不写入缓存，也不记录为加载失败
+func (c *Checker) EnsureYearLoaded(ctx context.Context, year int) error {
+	if c.IsYearLoaded(year) {
+		return nil
+	}
+	_, err := c.ensureYearLoaded(ctx, year)
+	return err
+}
+
+// ensureYearLoaded 加载年份数据并返回其索引，同一年份的并发调用共享一次加载
+func (c *Checker) ensureYearLoaded(ctx context.Context, year int) (*yearIndex, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	c.loadMu.Lock()
+	load := c.loading[year]
+	if load == nil {
+		// 加载使用独立的 context，不随发起加载的调用方取消
+		loadCtx, cancel := context.WithCancel(context.Background())
+		load = &yearLoad{done: make(chan struct{}), cancel: cancel}
+		if c.loading == nil {
+			c.loading = make(map[int]*yearLoad)
+		}
+		c.loading[year] = load
+		go func() {
+			defer close(load.done)
+			defer cancel()
+			load.idx, load.err = c.loadYear(loadCtx, year)
+
+			c.loadMu.Lock()
+			if c.loading[year] == load {
+				delete(c.loading, year)
+			}
+			c.loadMu.Unlock()
+		}()
+	}
+	load.waiters++
+	c.loadMu.Unlock()
+
+	select {
+	case <-load.done:
+		return load.idx, load.err
+	case <-ctx.Done():
+		c.loadMu.Lock()
+		load.waiters--
+		if load.waiters == 0 {
+			// 没有调用方再等待，中止加载；之后的调用方重新发起加载，不会拿到被中止的结果
+			load.cancel()
+			if c.loading[year] == load {
+				delete(c.loading, year)
+			}
+		}
+		c.loadMu.Unlock()
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,89 @@
+package cnholiday
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+func TestYearIndexRoundTrip(t *testing.T) {
+	for _, year := range []int{2024, 2025, 2026} {
+		raw, err := embeddedData.ReadFile(fmt.Sprintf("data/%d.json", year))
+		if err != nil {
+			t.Fatalf("read embedded %d: %v", year, err)
+		}
+		data, err := parseHolidayData(raw)
+		if err != nil {
+			t.Fatalf("parse embedded %d: %v", year, err)
+		}
+
+		// 数据中的补休日均包含在节假日中，索引可无损还原
+		got := newYearIndex(year, data).holidayData()
+		if !reflect.DeepEqual(got, data) {
+			t.Errorf("%d: round trip mismatch\ngot:  %v\nwant: %v", year, got, data)
+		}
+	}
+}
+
+func TestYearIndexInterning(t *testing.T) {
+	data := &HolidayData{
+		Holidays: map[string]string{
+			"2026-10-01": "国庆节",
+			"2026-10-02": "国庆节",
+			"2026-10-03": "国庆节",
+		},
+		Workdays:   map[string]string{"2026-10-10": "国庆节"},
+		InLieuDays: map[string]string{},
+	}
+	idx := newYearIndex(2026, data)
+	if len(idx.table) != 2 {
+		t.Errorf("len(table) = %d, want 2 (empty + 国庆节)", len(idx.table))
+	}
+	if size := unsafe.Sizeof(*idx); size > 1200 {
+		t.Errorf("yearIndex size = %d bytes, want <= 1200", size)
+	}
+
+	dayType, name := idx.lookup(time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC))
+	if dayType != DayTypeAdjustedWorkday || name != "国庆节" {
+		t.Errorf("lookup(2026-10-10) = %v, %q", dayType, name)
+	}
+	dayType, name = idx.lookup(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC))
+	if dayType != DayTypeWorkday || name != "" {
+		t.Errorf("lookup(2026-12-31) = %v, %q", dayType, name)
+	}
+}
+
+func TestYearDataIsolation(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	input := &HolidayData{
+		Holidays: map[string]string{"2030-10-01": "National Day,国庆节,3"},
+		Workdays: map[string]string{"2030-09-29": "National Day,国庆节,3"},
+	}
+	checker.Import(map[int]*HolidayData{2030: input})
+
+	// 导入后修改调用方的数据，缓存中保存的是副本
+	input.Holidays["2030-10-08"] = "modified"
+	delete(input.Workdays, "2030-09-29")
+
+	// PatchYear 基于缓存保存的年份数据重建索引，能暴露被调用方修改的共享 map
+	patch := &HolidayData{Holidays: map[string]string{"2030-10-02": "National Day,国庆节,3"}}
+	if _, err := checker.PatchYear(2030, patch); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	patch.Holidays["2030-10-09"] = "modified"
+
+	got := checker.Export()[2030]
+	want := &HolidayData{
+		Holidays: map[string]string{
+			"2030-10-01": "National Day,国庆节,3",
+			"2030-10-02": "National Day,国庆节,3",
+		},
+		Workdays:   map[string]string{"2030-09-29": "National Day,国庆节,3"},
+		InLieuDays: map[string]string{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Export()[2030] = %v, want %v", got, want)
+	}
+}
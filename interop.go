@@ -0,0 +1,101 @@
+package cnholiday
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// pythonMemberReplacer 将英文节日名称转换为 chinesecalendar 中 Holiday 枚举成员名
+var pythonMemberReplacer = strings.NewReplacer("'", "", "-", "_", " ", "_")
+
+// WriteChineseCalendar 以 Python chinesecalendar 库 constants.py 的格式输出指定年份的数据，
+// 生成 holidays、workdays、in_lieu_days 三个字典，可直接替换该库的数据定义
+func (c *Checker) WriteChineseCalendar(w io.Writer, years ...int) error {
+	years = slices.Clone(years)
+	slices.Sort(years)
+	years = slices.Compact(years)
+
+	indexes := make([]*yearIndex, len(years))
+	for i, year := range years {
+		idx, err := c.index(year)
+		if err != nil {
+			return err
+		}
+		indexes[i] = idx
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, section := range []struct {
+		name    string
+		matches func(DayType) bool
+	}{
+		{"holidays", func(t DayType) bool { return t == DayTypePublicHoliday || t == DayTypeInLieu }},
+		{"workdays", func(t DayType) bool { return t == DayTypeAdjustedWorkday }},
+		{"in_lieu_days", func(t DayType) bool { return t == DayTypeInLieu }},
+	} {
+		fmt.Fprintf(bw, "%s = {\n", section.name)
+		for _, idx := range indexes {
+			day := time.Date(idx.year, 1, 1, 0, 0, 0, 0, time.UTC)
+			for ; day.Year() == idx.year; day = day.AddDate(0, 0, 1) {
+				dayType, name := idx.lookup(day)
+				if !section.matches(dayType) {
+					continue
+				}
+				fmt.Fprintf(bw, "    datetime.date(year=%d, month=%d, day=%d): Holiday.%s.value,\n",
+					day.Year(), day.Month(), day.Day(), pythonHolidayMember(name))
+			}
+		}
+		bw.WriteString("}\n")
+	}
+	return bw.Flush()
+}
+
+// pythonHolidayMember 返回名称对应的 chinesecalendar 枚举成员名，例如 "New Year's Day" -> new_years_day
+func pythonHolidayMember(name string) string {
+	english, _, _ := strings.Cut(name, ",")
+	return strings.ToLower(pythonMemberReplacer.Replace(english))
+}
+
+// holidayCNDay holiday-cn 数据格式中的单日记录
+type holidayCNDay struct {
+	Name     string `json:"name"`
+	Date     string `json:"date"`
+	IsOffDay bool   `json:"isOffDay"`
+}
+
+// WriteHolidayCN 以 holiday-cn 项目的 JSON 格式输出指定年份的数据，Java 等语言的常用节假日库均使用该格式
+// days 按日期排序，放假日期 isOffDay 为 true，调休上班日期为 false
+func (c *Checker) WriteHolidayCN(w io.Writer, year int) error {
+	idx, err := c.index(year)
+	if err != nil {
+		return err
+	}
+
+	days := []holidayCNDay{}
+	day := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	for ; day.Year() == year; day = day.AddDate(0, 0, 1) {
+		dayType, name := idx.lookup(day)
+		switch dayType {
+		case DayTypePublicHoliday, DayTypeInLieu, DayTypeAdjustedWorkday:
+			days = append(days, holidayCNDay{
+				Name:     chineseName(name),
+				Date:     day.Format("2006-01-02"),
+				IsOffDay: dayType != DayTypeAdjustedWorkday,
+			})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Year   int            `json:"year"`
+		Papers []string       `json:"papers"`
+		Days   []holidayCNDay `json:"days"`
+	}{year, []string{}, days})
+}
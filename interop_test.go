@@ -0,0 +1,78 @@
+package cnholiday
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestWriteChineseCalendar(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var b strings.Builder
+	if err := checker.WriteChineseCalendar(&b, 2026, 2025, 2026); err != nil {
+		t.Fatalf("WriteChineseCalendar failed: %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"holidays = {\n    datetime.date(year=2025, month=1, day=1): Holiday.new_years_day.value,\n",
+		"    datetime.date(year=2026, month=10, day=1): Holiday.national_day.value,\n",
+		"workdays = {\n",
+		"    datetime.date(year=2026, month=10, day=10): Holiday.national_day.value,\n",
+		"in_lieu_days = {\n",
+		"Holiday.tomb_sweeping_day.value",
+		"Holiday.mid_autumn_festival.value",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q", want)
+		}
+	}
+	if n := strings.Count(out, "datetime.date(year=2026, month=1, day=1)"); n != 1 {
+		t.Errorf("2026-01-01 listed %d times, want 1 (duplicate years should be ignored)", n)
+	}
+
+	if got := pythonHolidayMember("New Year's Day,元旦,1"); got != "new_years_day" {
+		t.Errorf("pythonHolidayMember() = %q", got)
+	}
+}
+
+func TestWriteHolidayCN(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var b strings.Builder
+	if err := checker.WriteHolidayCN(&b, 2026); err != nil {
+		t.Fatalf("WriteHolidayCN failed: %v", err)
+	}
+
+	var doc struct {
+		Year int `json:"year"`
+		Days []struct {
+			Name     string `json:"name"`
+			Date     string `json:"date"`
+			IsOffDay bool   `json:"isOffDay"`
+		} `json:"days"`
+	}
+	if err := json.Unmarshal([]byte(b.String()), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if doc.Year != 2026 || len(doc.Days) == 0 {
+		t.Fatalf("doc = %+v", doc)
+	}
+	if d := doc.Days[0]; d.Name != "元旦" || d.Date != "2026-01-01" || !d.IsOffDay {
+		t.Errorf("first day = %+v", d)
+	}
+
+	found := false
+	for _, d := range doc.Days {
+		if d.Date == "2026-10-10" {
+			found = true
+			if d.IsOffDay || d.Name != "国庆节" {
+				t.Errorf("2026-10-10 = %+v, want 国庆节 workday", d)
+			}
+		}
+	}
+	if !found {
+		t.Error("adjusted workday 2026-10-10 missing")
+	}
+}
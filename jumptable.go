@@ -0,0 +1,106 @@
+package cnholiday
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// workdayTable 工作日跳转表，每段覆盖若干个连续的已加载年份，由 cache 的某个版本构建
+type workdayTable struct {
+	gen      uint64 // 构建时 cache 的版本号，与 Checker.cacheGen 不一致时需要重建
+	segments []workdaySegment
+}
+
+// workdaySegment 跳转表中的一段，日期用相对 first 的天数表示
+type workdaySegment struct {
+	first    int     // 第一天(1 月 1 日)距 1970-01-01 的天数
+	after    []int32 // after[d] 为第 d 天及之前的工作日个数，即第 d 天之后第一个工作日在 workdays 中的下标
+	workdays []int32 // 工作日的天数，升序
+}
+
+// jumpWorkdays 通过跳转表返回 date 之后第 n 个工作日(不含当天)，n 为负数时向前查找
+// 未开启 Config.WorkdayJumpTable、date 或结果不在表覆盖的年份内时返回 false，由调用方按原方式查找
+func (c *Checker) jumpWorkdays(date time.Time, n int) (time.Time, bool) {
+	if n == 0 || !c.settings().WorkdayJumpTable {
+		return time.Time{}, false
+	}
+	table := c.workdayTable()
+	day := dayNumber(date)
+	for i := range table.segments {
+		seg := &table.segments[i]
+		d := day - seg.first
+		if d < 0 || d >= len(seg.after) {
+			continue
+		}
+
+		a := int(seg.after[d])
+		j := a + n - 1
+		if n < 0 {
+			if a > 0 && seg.workdays[a-1] == int32(d) { // 当天是工作日，不计入
+				a--
+			}
+			j = a + n
+		}
+		if j < 0 || j >= len(seg.workdays) {
+			return time.Time{}, false
+		}
+		return date.AddDate(0, 0, int(seg.workdays[j])-d), true
+	}
+	return time.Time{}, false
+}
+
+// workdayTable 返回与当前缓存一致的跳转表，缓存变更后重建
+func (c *Checker) workdayTable() *workdayTable {
+	gen := c.cacheGen.Load()
+	if table := c.table.Load(); table != nil && table.gen == gen {
+		return table
+	}
+
+	c.tableMu.Lock()
+	defer c.tableMu.Unlock()
+	c.mu.RLock()
+	gen = c.cacheGen.Load()
+	if table := c.table.Load(); table != nil && table.gen == gen {
+		c.mu.RUnlock()
+		return table
+	}
+	// 索引不可变，持有快照后即可释放锁
+	indexes := make(map[int]*yearIndex, len(c.cache))
+	for year, idx := range c.cache {
+		indexes[year] = idx
+	}
+	c.mu.RUnlock()
+
+	table := buildWorkdayTable(gen, indexes)
+	c.table.Store(table)
+	return table
+}
+
+// buildWorkdayTable 将年份索引按连续年份分段，构建跳转表
+func buildWorkdayTable(gen uint64, indexes map[int]*yearIndex) *workdayTable {
+	table := &workdayTable{gen: gen}
+	years := slices.Sorted(maps.Keys(indexes))
+	for start := 0; start < len(years); {
+		end := start + 1
+		for end < len(years) && years[end] == years[end-1]+1 {
+			end++
+		}
+
+		seg := workdaySegment{first: dayNumber(time.Date(years[start], 1, 1, 0, 0, 0, 0, time.UTC))}
+		for _, year := range years[start:end] {
+			idx := indexes[year]
+			offset := len(seg.after)
+			days := time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+			for i, t := range idx.types[:days] {
+				if t.IsWorkday() {
+					seg.workdays = append(seg.workdays, int32(offset+i))
+				}
+				seg.after = append(seg.after, int32(len(seg.workdays)))
+			}
+		}
+		table.segments = append(table.segments, seg)
+		start = end
+	}
+	return table
+}
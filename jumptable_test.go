@@ -0,0 +1,67 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkdayJumpTable(t *testing.T) {
+	plain := NewCheckerWithConfig(Config{DisableRemote: true})
+	jump := NewCheckerWithConfig(Config{DisableRemote: true, WorkdayJumpTable: true})
+	for _, checker := range []*Checker{plain, jump} {
+		if err := checker.LoadYears(t.Context(), 2024, 2025, 2026); err != nil {
+			t.Fatalf("LoadYears failed: %v", err)
+		}
+	}
+
+	// 结果与逐日查找一致，超出已加载年份时同样返回错误
+	loc := time.FixedZone("UTC+8", 8*3600)
+	for date := time.Date(2024, 1, 1, 14, 0, 0, 0, loc); date.Year() <= 2026; date = date.AddDate(0, 0, 3) {
+		for _, n := range []int{-300, -25, -2, -1, 1, 2, 25, 300} {
+			want, wantErr := plain.AddWorkdays(date, n)
+			got, err := jump.AddWorkdays(date, n)
+			if (err != nil) != (wantErr != nil) || !got.Equal(want) {
+				t.Fatalf("AddWorkdays(%s, %d) = %s, %v, want %s, %v", date.Format("2006-01-02"), n, got, err, want, wantErr)
+			}
+		}
+		want, wantErr := plain.NextWorkday(date)
+		if got, err := jump.NextWorkday(date); (err != nil) != (wantErr != nil) || !got.Equal(want) {
+			t.Fatalf("NextWorkday(%s) = %s, %v, want %s, %v", date.Format("2006-01-02"), got, err, want, wantErr)
+		}
+		want, wantErr = plain.PrevWorkday(date)
+		if got, err := jump.PrevWorkday(date); (err != nil) != (wantErr != nil) || !got.Equal(want) {
+			t.Fatalf("PrevWorkday(%s) = %s, %v, want %s, %v", date.Format("2006-01-02"), got, err, want, wantErr)
+		}
+	}
+	if table := jump.table.Load(); table == nil || len(table.segments) != 1 || len(table.segments[0].after) != 366+365+365 {
+		t.Fatalf("table should cover 2024-2026 in one segment")
+	}
+
+	// 数据变更后重建
+	friday := time.Date(2026, 10, 9, 0, 0, 0, 0, time.Local) // 下一个工作日原为调休上班的周六 10-10
+	if _, err := jump.PatchYear(2026, &HolidayData{Workdays: map[string]string{"2026-10-10": ""}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	if got, _ := jump.NextWorkday(friday); got.Format("2006-01-02") != "2026-10-12" {
+		t.Errorf("NextWorkday after patch = %s, want 2026-10-12", got.Format("2006-01-02"))
+	}
+
+	// 清除中间的年份后分为两段
+	jump.ClearYear(2025)
+	jump.NextWorkday(friday)
+	if table := jump.table.Load(); len(table.segments) != 2 {
+		t.Errorf("segments = %d, want 2 after clearing 2025", len(table.segments))
+	}
+}
+
+func BenchmarkAddWorkdaysJumpTable(b *testing.B) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true, WorkdayJumpTable: true})
+	if err := checker.LoadYears(b.Context(), 2024, 2025, 2026); err != nil {
+		b.Fatal(err)
+	}
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.Local)
+	b.ReportAllocs()
+	for b.Loop() {
+		checker.AddWorkdays(date, 20)
+	}
+}
@@ -0,0 +1,205 @@
+package cnholiday
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Layer 命名的日历层，在法定日历之上描述学校校历、机关工作日历等其他日历，每层按日期区间使用自己的日期类型
+type Layer struct {
+	Name    string
+	Periods []LayerPeriod // 区间重叠时以后定义的为准
+}
+
+// LayerPeriod 日历层中的一个区间
+// Off 为 true 的区间(如寒假)内每一天都是休息日，类型均为 Type；
+// 其余区间(如学期)只把法定日历中需要上班的日期标记为 Type，周末和节假日保持不变
+type LayerPeriod struct {
+	Start time.Time // 闭区间，按日期比较
+	End   time.Time
+	Type  string // 该层自定义的日期类型编码，例如 "term"、"vacation"，不能与内置类型编码重复
+	Name  string // 区间名称，例如"秋季学期"
+	Off   bool   // 区间内是否休息
+
+	dayType DayType // Type 注册后的日期类型
+}
+
+// layerJSON 日历层的 JSON 格式
+type layerJSON struct {
+	Periods []struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+		Type  string `json:"type"`
+		Name  string `json:"name"`
+		Off   bool   `json:"off"`
+	} `json:"periods"`
+}
+
+// ParseLayer 解析 JSON 格式的日历层，格式为
+//
+//	{"periods": [
+//	  {"start": "2026-09-01", "end": "2027-01-15", "type": "term", "name": "秋季学期"},
+//	  {"start": "2027-01-16", "end": "2027-02-28", "type": "vacation", "name": "寒假", "off": true}
+//	]}
+func ParseLayer(name string, data []byte) (*Layer, error) {
+	var doc layerJSON
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("解析 JSON 失败: %w", err)
+	}
+
+	layer := &Layer{Name: name}
+	for i, p := range doc.Periods {
+		start, err := time.Parse("2006-01-02", p.Start)
+		if err != nil {
+			return nil, fmt.Errorf("periods[%d]: 开始日期格式无效: %s", i, p.Start)
+		}
+		end, err := time.Parse("2006-01-02", p.End)
+		if err != nil {
+			return nil, fmt.Errorf("periods[%d]: 结束日期格式无效: %s", i, p.End)
+		}
+		layer.Periods = append(layer.Periods, LayerPeriod{Start: start, End: end, Type: p.Type, Name: p.Name, Off: p.Off})
+	}
+	return layer, nil
+}
+
+// SetLayer 添加或替换同名的日历层，并注册其中的日期类型
+// 同一类型编码在所有日历层中的 Off 必须一致
+func (c *Checker) SetLayer(layer *Layer) error {
+	if layer == nil || layer.Name == "" {
+		return errors.New("日历层名称不能为空")
+	}
+
+	periods := slices.Clone(layer.Periods)
+	for i := range periods {
+		p := &periods[i]
+		if dayNumber(p.End) < dayNumber(p.Start) {
+			return fmt.Errorf("日历层 %s 第 %d 个区间的结束日期早于开始日期", layer.Name, i+1)
+		}
+		t, err := registerDayType(p.Type, !p.Off)
+		if err != nil {
+			return fmt.Errorf("日历层 %s 第 %d 个区间: %w", layer.Name, i+1, err)
+		}
+		p.dayType = t
+	}
+
+	c.layerMu.Lock()
+	defer c.layerMu.Unlock()
+	if c.layers == nil {
+		c.layers = make(map[string]*Layer)
+	}
+	c.layers[layer.Name] = &Layer{Name: layer.Name, Periods: periods}
+	return nil
+}
+
+// RemoveLayer 删除日历层
+func (c *Checker) RemoveLayer(name string) {
+	c.layerMu.Lock()
+	delete(c.layers, name)
+	c.layerMu.Unlock()
+}
+
+// Layers 返回已添加的日历层名称，按字典序排列
+func (c *Checker) Layers() []string {
+	c.layerMu.RLock()
+	defer c.layerMu.RUnlock()
+	return slices.Sorted(func(yield func(string) bool) {
+		for name := range c.layers {
+			if !yield(name) {
+				return
+			}
+		}
+	})
+}
+
+// Layer 返回按日历层查询的视图，与 Checker 使用相同的查询接口
+// 返回值是调用时刻的快照，之后通过 SetLayer 替换该层不会影响已返回的视图
+func (c *Checker) Layer(name string) (*LayerCalendar, error) {
+	c.layerMu.RLock()
+	layer := c.layers[name]
+	c.layerMu.RUnlock()
+	if layer == nil {
+		return nil, fmt.Errorf("日历层 %s 不存在", name)
+	}
+	return &LayerCalendar{Name: name, base: c, periods: layer.Periods}, nil
+}
+
+// LayerCalendar 按日历层查询的视图，未被该层区间覆盖的日期使用法定日历
+type LayerCalendar struct {
+	Name    string
+	base    *Checker
+	periods []LayerPeriod // 只读快照
+}
+
+var _ HolidayChecker = (*LayerCalendar)(nil)
+
+// Period 返回 date 所在的区间，不在任何区间内时返回 false
+func (l *LayerCalendar) Period(date time.Time) (LayerPeriod, bool) {
+	day := dayNumber(date)
+	for i := len(l.periods) - 1; i >= 0; i-- {
+		p := l.periods[i]
+		if day >= dayNumber(p.Start) && day <= dayNumber(p.End) {
+			return p, true
+		}
+	}
+	return LayerPeriod{}, false
+}
+
+// Classify 返回指定日期在该层中的类型及名称，被该层区间覆盖时返回区间的自定义类型和区间名称
+func (l *LayerCalendar) Classify(date time.Time) (DayType, string, error) {
+	dayType, name, _, err := l.base.classify(date)
+	if err != nil {
+		return dayType, name, err
+	}
+	if p, ok := l.Period(date); ok && (p.Off || dayType.IsWorkday()) {
+		return p.dayType, p.Name, nil
+	}
+	return dayType, name, nil
+}
+
+// IsHoliday 判断指定日期在该层中是否是休息日
+func (l *LayerCalendar) IsHoliday(date time.Time) (bool, string, error) {
+	dayType, name, err := l.Classify(date)
+	if err != nil {
+		return false, "", err
+	}
+	if dayType == DayTypeWeekend {
+		name = "周末"
+	}
+	return !dayType.IsWorkday(), name, nil
+}
+
+// IsWorkday 判断指定日期在该层中是否是工作日
+func (l *LayerCalendar) IsWorkday(date time.Time) (bool, error) {
+	dayType, _, err := l.Classify(date)
+	if err != nil {
+		return false, err
+	}
+	return dayType.IsWorkday(), nil
+}
+
+// GetHolidayInfo 获取指定日期在该层中的详细信息，被该层区间覆盖时 CustomType 为区间的自定义类型
+func (l *LayerCalendar) GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
+	info, err := l.base.GetHolidayInfo(date)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := l.Period(date); ok && (p.Off || info.IsWorkday) {
+		source, version, heuristic := info.Source, info.DataVersion, info.Heuristic
+		fillHolidayInfo(info, date, p.dayType, p.Name)
+		info.Source, info.DataVersion, info.Heuristic = source, version, heuristic
+	}
+	return info, nil
+}
+
+// CountWorkdaysBetween 统计该层中 [start, end] 闭区间内的工作日天数
+func (l *LayerCalendar) CountWorkdaysBetween(start, end time.Time) (int, error) {
+	return CountWorkdays(l.IsWorkday, start, end)
+}
+
+// AddWorkdays 返回该层中 date 之后第 n 个工作日(不含当天)，n 为负数时向前查找
+func (l *LayerCalendar) AddWorkdays(date time.Time, n int) (time.Time, error) {
+	return StepWorkdays(l.IsWorkday, date, n)
+}
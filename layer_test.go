@@ -0,0 +1,122 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+const schoolLayerJSON = `{"periods": [
+	{"start": "2026-07-04", "end": "2026-08-31", "type": "school_vacation", "name": "暑假", "off": true},
+	{"start": "2026-09-01", "end": "2026-12-31", "type": "school_term", "name": "秋季学期"},
+	{"start": "2026-11-09", "end": "2026-11-13", "type": "school_vacation", "name": "秋假", "off": true}
+]}`
+
+func newSchoolLayer(t *testing.T) (*Checker, *LayerCalendar) {
+	t.Helper()
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	layer, err := ParseLayer("school", []byte(schoolLayerJSON))
+	if err != nil {
+		t.Fatalf("ParseLayer failed: %v", err)
+	}
+	if err := checker.SetLayer(layer); err != nil {
+		t.Fatalf("SetLayer failed: %v", err)
+	}
+	cal, err := checker.Layer("school")
+	if err != nil {
+		t.Fatalf("Layer failed: %v", err)
+	}
+	return checker, cal
+}
+
+func TestLayerClassify(t *testing.T) {
+	_, cal := newSchoolLayer(t)
+	term, _ := ParseDayType("school_term")
+	vacation, _ := ParseDayType("school_vacation")
+
+	tests := []struct {
+		date     string
+		wantType DayType
+		wantName string
+	}{
+		{"2026-06-30", DayTypeWorkday, ""},          // 层外使用法定日历
+		{"2026-07-06", vacation, "暑假"},              // 休息区间覆盖工作日
+		{"2026-07-04", vacation, "暑假"},              // 休息区间覆盖周末
+		{"2026-09-01", term, "秋季学期"},                // 学期内的工作日
+		{"2026-09-19", DayTypeWeekend, ""},          // 学期内的周末保持不变
+		{"2026-09-20", term, "秋季学期"},                // 调休上班日同样上课
+		{"2026-10-01", DayTypePublicHoliday, "国庆节"}, // 学期内的法定节假日保持不变
+		{"2026-11-10", vacation, "秋假"},              // 后定义的区间优先
+	}
+	for _, tt := range tests {
+		date, _ := time.Parse("2006-01-02", tt.date)
+		gotType, gotName, err := cal.Classify(date)
+		if err != nil {
+			t.Fatalf("Classify(%s) failed: %v", tt.date, err)
+		}
+		if gotType != tt.wantType || chineseName(gotName) != tt.wantName {
+			t.Errorf("Classify(%s) = %v, %q, want %v, %q", tt.date, gotType, gotName, tt.wantType, tt.wantName)
+		}
+	}
+}
+
+func TestLayerWorkdays(t *testing.T) {
+	checker, cal := newSchoolLayer(t)
+	start := time.Date(2026, 11, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2026, 11, 30, 0, 0, 0, 0, time.Local)
+
+	base, _ := checker.CountWorkdaysBetween(start, end)
+	got, err := cal.CountWorkdaysBetween(start, end)
+	if err != nil {
+		t.Fatalf("CountWorkdaysBetween failed: %v", err)
+	}
+	if got != base-5 {
+		t.Errorf("CountWorkdaysBetween = %d, want %d", got, base-5)
+	}
+
+	next, err := cal.AddWorkdays(time.Date(2026, 11, 6, 0, 0, 0, 0, time.Local), 1)
+	if err != nil {
+		t.Fatalf("AddWorkdays failed: %v", err)
+	}
+	if want := time.Date(2026, 11, 16, 0, 0, 0, 0, time.Local); !next.Equal(want) {
+		t.Errorf("AddWorkdays = %v, want %v", next, want)
+	}
+
+	info, err := cal.GetHolidayInfo(time.Date(2026, 11, 10, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if info.IsWorkday || info.HolidayName != "秋假" || info.DayType().String() != "school_vacation" {
+		t.Errorf("GetHolidayInfo = %+v", info)
+	}
+}
+
+func TestLayerManagement(t *testing.T) {
+	checker, cal := newSchoolLayer(t)
+	if names := checker.Layers(); len(names) != 1 || names[0] != "school" {
+		t.Errorf("Layers = %v", names)
+	}
+
+	// 同一编码不能既是工作日又是休息日
+	conflict := &Layer{Name: "other", Periods: []LayerPeriod{{
+		Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		Type:  "school_term",
+		Off:   true,
+	}}}
+	if err := checker.SetLayer(conflict); err == nil {
+		t.Error("SetLayer should reject a type registered with a different workday flag")
+	}
+	conflict.Periods[0].Type = "workday"
+	if err := checker.SetLayer(conflict); err == nil {
+		t.Error("SetLayer should reject builtin type codes")
+	}
+
+	checker.RemoveLayer("school")
+	if _, err := checker.Layer("school"); err == nil {
+		t.Error("Layer should fail after RemoveLayer")
+	}
+	// 已返回的视图不受影响
+	if ok, _ := cal.IsWorkday(time.Date(2026, 11, 10, 0, 0, 0, 0, time.Local)); ok {
+		t.Error("snapshot should still treat 秋假 as a day off")
+	}
+}
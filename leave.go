@@ -0,0 +1,94 @@
+package cnholiday
+
+import (
+	"errors"
+	"time"
+)
+
+// 国家规定的假期天数，各地方性法规可能在此基础上延长
+const (
+	MarriageLeaveDays           = 3  // 婚假
+	FuneralLeaveDays            = 3  // 丧假(直系亲属)
+	HomeVisitSpouseDays         = 30 // 探亲假：探望配偶，每年一次
+	HomeVisitParentsDays        = 20 // 探亲假：未婚职工探望父母，每年一次
+	HomeVisitParentsMarriedDays = 20 // 探亲假：已婚职工探望父母，每四年一次
+)
+
+// LeaveType 假期类型
+type LeaveType int
+
+const (
+	LeaveAnnual    LeaveType = iota // 带薪年休假
+	LeaveMarriage                   // 婚假
+	LeaveFuneral                    // 丧假
+	LeaveHomeVisit                  // 探亲假
+)
+
+// CountsCalendarDays 假期是否按自然日计算
+// 探亲假包括公休假日和法定节日在内；年休假、婚丧假遇休息日和法定节假日顺延
+func (t LeaveType) CountsCalendarDays() bool {
+	return t == LeaveHomeVisit
+}
+
+// AnnualLeaveDays 根据《职工带薪年休假条例》返回累计工作年限对应的年休假天数
+// 满 1 年不满 10 年 5 天，满 10 年不满 20 年 10 天，满 20 年 15 天
+func AnnualLeaveDays(workYears int) int {
+	switch {
+	case workYears >= 20:
+		return 15
+	case workYears >= 10:
+		return 10
+	case workYears >= 1:
+		return 5
+	}
+	return 0
+}
+
+// LeaveSpan 假期在日历上的实际区间
+type LeaveSpan struct {
+	Start        time.Time // 第一天假期
+	End          time.Time // 最后一天假期
+	CalendarDays int       // 从 Start 到 End 的自然日天数
+}
+
+// maxLeaveDays 单次假期天数上限
+const maxLeaveDays = 366
+
+// LeaveSpan 计算从 start 开始休 days 天假的实际区间
+// 按工作日计算的假期从 start 起第一个工作日开始，跳过周末、法定节假日和补休日；
+// 区间中间夹带的休息日计入 CalendarDays
+func (c *Checker) LeaveSpan(start time.Time, days int, leave LeaveType) (*LeaveSpan, error) {
+	if days <= 0 {
+		return nil, errors.New("假期天数必须大于 0")
+	}
+	if days > maxLeaveDays {
+		return nil, errors.New("假期天数超过上限")
+	}
+
+	if leave.CountsCalendarDays() {
+		return &LeaveSpan{
+			Start:        start,
+			End:          start.AddDate(0, 0, days-1),
+			CalendarDays: days,
+		}, nil
+	}
+
+	span := &LeaveSpan{}
+	remaining := days
+	for date := start; remaining > 0; date = date.AddDate(0, 0, 1) {
+		isWorkday, err := c.IsWorkday(date)
+		if err != nil {
+			return nil, err
+		}
+		if !isWorkday {
+			continue
+		}
+		if span.Start.IsZero() {
+			span.Start = date
+		}
+		span.End = date
+		remaining--
+	}
+	span.CalendarDays = dayNumber(span.End) - dayNumber(span.Start) + 1
+	return span, nil
+}
@@ -0,0 +1,66 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnnualLeaveDays(t *testing.T) {
+	tests := []struct {
+		years    int
+		expected int
+	}{
+		{0, 0}, {1, 5}, {9, 5}, {10, 10}, {19, 10}, {20, 15}, {35, 15},
+	}
+	for _, tt := range tests {
+		if got := AnnualLeaveDays(tt.years); got != tt.expected {
+			t.Errorf("AnnualLeaveDays(%d) = %d, want %d", tt.years, got, tt.expected)
+		}
+	}
+}
+
+func TestLeaveSpan(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+
+	tests := []struct {
+		name   string
+		start  string
+		days   int
+		leave  LeaveType
+		end    string
+		first  string
+		length int
+	}{
+		// 9 月 28 日(周一)起休 5 天年假，国庆假期顺延到 10 月 9 日
+		{"年假跨国庆", "2026-09-28", 5, LeaveAnnual, "2026-10-09", "2026-09-28", 12},
+		// 从假期中开始，第一个工作日为 10 月 8 日，10 日为调休工作日
+		{"从假期开始", "2026-10-03", 3, LeaveMarriage, "2026-10-10", "2026-10-08", 3},
+		{"探亲假按自然日", "2026-10-01", 30, LeaveHomeVisit, "2026-10-30", "2026-10-01", 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			span, err := checker.LeaveSpan(day(tt.start), tt.days, tt.leave)
+			if err != nil {
+				t.Fatalf("LeaveSpan failed: %v", err)
+			}
+			if !span.Start.Equal(day(tt.first)) || !span.End.Equal(day(tt.end)) || span.CalendarDays != tt.length {
+				t.Errorf("LeaveSpan() = %s ~ %s (%d), want %s ~ %s (%d)",
+					span.Start.Format("2006-01-02"), span.End.Format("2006-01-02"), span.CalendarDays,
+					tt.first, tt.end, tt.length)
+			}
+		})
+	}
+
+	if _, err := checker.LeaveSpan(day("2026-10-01"), 0, LeaveAnnual); err == nil {
+		t.Error("expected error for zero days")
+	}
+}
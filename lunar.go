@@ -0,0 +1,128 @@
+package cnholiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// springFestivalFirstYear springFestivalDates 中第一个年份
+const springFestivalFirstYear = 2004
+
+// springFestivalDates 2004 ~ 2050 年春节(正月初一)的公历日期，按 月*100+日 存储
+var springFestivalDates = [...]uint16{
+	122, 209, 129, 218, 207, 126, 214, 203, 123, 210, // 2004 ~ 2013
+	131, 219, 208, 128, 216, 205, 125, 212, 201, 122, // 2014 ~ 2023
+	210, 129, 217, 206, 126, 213, 203, 123, 211, 131, // 2024 ~ 2033
+	219, 208, 128, 215, 204, 124, 212, 201, 122, 210, // 2034 ~ 2043
+	130, 217, 206, 126, 214, 202, 123, // 2044 ~ 2050
+}
+
+// dragonBoatDates 2004 ~ 2050 年端午节(五月初五)的公历日期，存储方式与 springFestivalDates 相同
+var dragonBoatDates = [...]uint16{
+	622, 611, 531, 619, 608, 528, 616, 606, 623, 612, // 2004 ~ 2013
+	602, 620, 609, 530, 618, 607, 625, 614, 603, 622, // 2014 ~ 2023
+	610, 531, 619, 609, 528, 616, 605, 624, 612, 601, // 2024 ~ 2033
+	620, 610, 530, 618, 607, 527, 614, 603, 622, 611, // 2034 ~ 2043
+	531, 619, 608, 529, 615, 604, 623, // 2044 ~ 2050
+}
+
+// midAutumnDates 2004 ~ 2050 年中秋节(八月十五)的公历日期，存储方式与 springFestivalDates 相同
+var midAutumnDates = [...]uint16{
+	928, 918, 1006, 925, 914, 1003, 922, 912, 930, 919, // 2004 ~ 2013
+	908, 927, 915, 1004, 924, 913, 1001, 921, 910, 929, // 2014 ~ 2023
+	917, 1006, 925, 915, 1003, 922, 912, 1001, 919, 908, // 2024 ~ 2033
+	927, 916, 1004, 924, 913, 1002, 920, 910, 928, 917, // 2034 ~ 2043
+	1005, 925, 915, 1004, 922, 911, 930, // 2044 ~ 2050
+}
+
+// SpringFestival 返回 year 年春节(正月初一)的日期(time.Local)，支持 2004 ~ 2050 年
+func SpringFestival(year int) (time.Time, error) {
+	festival, ok := lunarTableDate(springFestivalDates[:], year)
+	if !ok {
+		return time.Time{}, fmt.Errorf("没有 %d 年的农历数据", year)
+	}
+	return festival, nil
+}
+
+// lunarTableDate 从按 月*100+日 存储、首年为 springFestivalFirstYear 的日期表中取 year 年的日期(time.Local)
+func lunarTableDate(table []uint16, year int) (time.Time, bool) {
+	i := year - springFestivalFirstYear
+	if i < 0 || i >= len(table) {
+		return time.Time{}, false
+	}
+	md := int(table[i])
+	return time.Date(year, time.Month(md/100), md%100, 0, 0, 0, 0, time.Local), true
+}
+
+// qingMing 返回 year 年清明(太阳到达黄经 15°)的日期(time.Local)，按 21 世纪的寿星通式 [Y*D+C]-L 计算，
+// 只支持 2001 ~ 2099 年
+func qingMing(year int) (time.Time, bool) {
+	if year <= 2000 || year >= 2100 {
+		return time.Time{}, false
+	}
+	y := year % 100
+	day := int(float64(y)*0.2422+4.81) - y/4
+	return time.Date(year, time.April, day, 0, 0, 0, 0, time.Local), true
+}
+
+// 春运从春节前 15 天开始(即除夕前 14 天)，至春节后 24 天结束(即除夕后 25 天)，共 40 天
+const (
+	chunyunDaysBefore = 15
+	chunyunDaysAfter  = 24
+)
+
+// ChunyunWindow 返回 year 年春运的起止日期(闭区间，time.Local)，按春运共 40 天、从春节前 15 天开始的惯例计算，
+// 与 2024 ~ 2026 年官方公布的春运时间一致。支持年份与 SpringFestival 相同
+func ChunyunWindow(year int) (start, end time.Time, err error) {
+	festival, err := SpringFestival(year)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return festival.AddDate(0, 0, -chunyunDaysBefore), festival.AddDate(0, 0, chunyunDaysAfter), nil
+}
+
+// LunarAlignedDate 以春节为基准，返回 yearsBack 年前与 date 相对春节位置相同的日期，
+// 例如 date 为春节后第 3 天，则返回去年春节后第 3 天。春节在公历中的日期每年相差可达一个月，
+// 零售、物流等春节前后的同比分析应按该日期对齐，而不是按日历日期。
+// date 以距离最近的春节为基准(可能是上一年或下一年的春节)，yearsBack 为负数时向后对齐
+func LunarAlignedDate(date time.Time, yearsBack int) (time.Time, error) {
+	day := truncateDay(date)
+
+	var base time.Time
+	var offset int
+	for _, year := range []int{day.Year() - 1, day.Year(), day.Year() + 1} {
+		festival, err := SpringFestival(year)
+		if err != nil {
+			continue
+		}
+		festival = time.Date(festival.Year(), festival.Month(), festival.Day(), 0, 0, 0, 0, day.Location())
+		diff := daysBetween(festival, day)
+		if base.IsZero() || abs(diff) < abs(offset) {
+			base, offset = festival, diff
+		}
+	}
+	if base.IsZero() {
+		return time.Time{}, fmt.Errorf("没有 %d 年的农历数据", day.Year())
+	}
+
+	target, err := SpringFestival(base.Year() - yearsBack)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(target.Year(), target.Month(), target.Day()+offset, 0, 0, 0, 0, day.Location()), nil
+}
+
+// daysBetween 返回从 from 到 to 相差的自然日数，两者均为同一时区的零点
+func daysBetween(from, to time.Time) int {
+	// 按 UTC 日期计算，避免夏令时导致的 23/25 小时误差
+	f := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(t.Sub(f).Hours() / 24)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,92 @@
+package cnholiday
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSpringFestival(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	for year, want := range map[int]string{2004: "2004-01-22", 2024: "2024-02-10", 2025: "2025-01-29", 2026: "2026-02-17", 2033: "2033-01-31", 2050: "2050-01-23"} {
+		got, err := SpringFestival(year)
+		if err != nil || got.Format("2006-01-02") != want {
+			t.Errorf("SpringFestival(%d) = %s, %v; want %s", year, got.Format("2006-01-02"), err, want)
+		}
+		if year >= 2024 && year <= 2026 {
+			dayType, name, err := checker.Classify(got)
+			if err != nil || dayType != DayTypePublicHoliday || !strings.Contains(name, "春节") {
+				t.Errorf("%d 春节 in data = %s %q, %v", year, dayType, name, err)
+			}
+		}
+	}
+
+	for _, year := range []int{2003, 2051} {
+		if _, err := SpringFestival(year); err == nil {
+			t.Errorf("SpringFestival(%d) expected error", year)
+		}
+	}
+}
+
+func TestChunyunWindow(t *testing.T) {
+	// 官方公布的春运时间
+	for year, want := range map[int][2]string{
+		2024: {"2024-01-26", "2024-03-05"},
+		2025: {"2025-01-14", "2025-02-22"},
+		2026: {"2026-02-02", "2026-03-13"},
+	} {
+		start, end, err := ChunyunWindow(year)
+		if err != nil {
+			t.Fatalf("ChunyunWindow(%d) failed: %v", year, err)
+		}
+		if start.Format("2006-01-02") != want[0] || end.Format("2006-01-02") != want[1] {
+			t.Errorf("ChunyunWindow(%d) = %s ~ %s, want %s ~ %s", year, start.Format("2006-01-02"), end.Format("2006-01-02"), want[0], want[1])
+		}
+		if days := daysBetween(start, end) + 1; days != 40 {
+			t.Errorf("ChunyunWindow(%d) spans %d days, want 40", year, days)
+		}
+	}
+
+	if _, _, err := ChunyunWindow(2051); err == nil {
+		t.Error("expected error without lunar data")
+	}
+}
+
+func TestLunarAlignedDate(t *testing.T) {
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+
+	tests := []struct {
+		date      string
+		yearsBack int
+		want      string
+	}{
+		{"2026-02-20", 1, "2025-02-01"}, // 春节后第 3 天
+		{"2026-02-20", 2, "2024-02-13"},
+		{"2026-02-07", 1, "2025-01-19"},  // 春节前 10 天
+		{"2025-12-20", 1, "2024-12-01"},  // 距 2026 年春节更近，按春节前 59 天对齐
+		{"2024-02-10", -2, "2026-02-17"}, // 向后对齐
+	}
+	for _, tt := range tests {
+		got, err := LunarAlignedDate(day(tt.date), tt.yearsBack)
+		if err != nil {
+			t.Errorf("LunarAlignedDate(%s, %d) failed: %v", tt.date, tt.yearsBack, err)
+			continue
+		}
+		if got.Format("2006-01-02") != tt.want {
+			t.Errorf("LunarAlignedDate(%s, %d) = %s, want %s", tt.date, tt.yearsBack, got.Format("2006-01-02"), tt.want)
+		}
+		if got.Location() != time.UTC {
+			t.Errorf("LunarAlignedDate(%s) location = %v, want UTC", tt.date, got.Location())
+		}
+	}
+
+	if _, err := LunarAlignedDate(day("2026-02-20"), 30); err == nil {
+		t.Error("expected error without lunar data for target year")
+	}
+	if _, err := LunarAlignedDate(day("2070-02-20"), 1); err == nil {
+		t.Error("expected error without lunar data for date")
+	}
+}
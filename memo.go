@@ -0,0 +1,144 @@
+package cnholiday
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// defaultMemoSize WithMemo 默认缓存的日期数
+const defaultMemoSize = 1024
+
+// MemoChecker 按日期缓存查询结果的 HolidayChecker 装饰器，使用 LRU 淘汰
+// 查询出错时不缓存；底层数据更新后需要调用 Purge 清除缓存
+type MemoChecker struct {
+	next HolidayChecker
+	size int
+
+	mu      sync.Mutex
+	entries map[int]*list.Element
+	lru     *list.List // 最近使用的在前
+}
+
+type memoEntry struct {
+	key  int
+	info HolidayInfo
+}
+
+var _ HolidayChecker = (*MemoChecker)(nil)
+
+// WithMemo 为 next 增加按日期的 LRU 缓存，size <= 0 时使用默认大小 1024
+// 适用于 RemoteChecker 之前或极热的循环中
+func WithMemo(next HolidayChecker, size int) *MemoChecker {
+	if size <= 0 {
+		size = defaultMemoSize
+	}
+	return &MemoChecker{
+		next:    next,
+		size:    size,
+		entries: make(map[int]*list.Element, size),
+		lru:     list.New(),
+	}
+}
+
+// Purge 清除全部缓存
+func (m *MemoChecker) Purge() {
+	m.mu.Lock()
+	m.entries = make(map[int]*list.Element, m.size)
+	m.lru.Init()
+	m.mu.Unlock()
+}
+
+// Len 返回当前缓存的日期数
+func (m *MemoChecker) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
+
+// info 返回缓存的查询结果，未命中时查询 next 并写入缓存
+func (m *MemoChecker) info(date time.Time) (HolidayInfo, error) {
+	key := dayNumber(date)
+
+	m.mu.Lock()
+	if el, ok := m.entries[key]; ok {
+		m.lru.MoveToFront(el)
+		info := el.Value.(*memoEntry).info
+		m.mu.Unlock()
+		info.Date = date
+		return info, nil
+	}
+	m.mu.Unlock()
+
+	info, err := m.next.GetHolidayInfo(date)
+	if err != nil {
+		return HolidayInfo{}, err
+	}
+
+	m.mu.Lock()
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*memoEntry).info = *info
+		m.lru.MoveToFront(el)
+	} else {
+		m.entries[key] = m.lru.PushFront(&memoEntry{key: key, info: *info})
+		if m.lru.Len() > m.size {
+			oldest := m.lru.Back()
+			m.lru.Remove(oldest)
+			delete(m.entries, oldest.Value.(*memoEntry).key)
+		}
+	}
+	m.mu.Unlock()
+	return *info, nil
+}
+
+// GetHolidayInfo 获取节假日详细信息，每次返回新的副本
+func (m *MemoChecker) GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
+	info, err := m.info(date)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// Classify 返回指定日期的类型及对应的节日名称
+func (m *MemoChecker) Classify(date time.Time) (DayType, string, error) {
+	info, err := m.info(date)
+	if err != nil {
+		return DayTypeWorkday, "", err
+	}
+	return info.DayType(), info.HolidayName, nil
+}
+
+// IsHoliday 判断指定日期是否是节假日(休息日)
+func (m *MemoChecker) IsHoliday(date time.Time) (bool, string, error) {
+	info, err := m.info(date)
+	if err != nil {
+		return false, "", err
+	}
+	switch info.DayType() {
+	case DayTypeWeekend:
+		return true, "周末", nil
+	case DayTypeWorkday:
+		return false, "", nil
+	}
+	return info.IsHoliday, info.HolidayName, nil
+}
+
+// IsWorkday 判断指定日期是否是工作日
+func (m *MemoChecker) IsWorkday(date time.Time) (bool, error) {
+	info, err := m.info(date)
+	if err != nil {
+		return false, err
+	}
+	return info.IsWorkday, nil
+}
+
+// CountWorkdaysBetween 统计 [start, end] 闭区间内的工作日天数，逐日使用缓存
+func (m *MemoChecker) CountWorkdaysBetween(start, end time.Time) (int, error) {
+	return CountWorkdays(m.IsWorkday, start, end)
+}
+
+// AddWorkdays 返回 date 之后第 n 个工作日(不含当天)，逐日使用缓存
+func (m *MemoChecker) AddWorkdays(date time.Time, n int) (time.Time, error) {
+	return StepWorkdays(m.IsWorkday, date, n)
+}
@@ -0,0 +1,92 @@
+package cnholiday
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// countingChecker 统计 GetHolidayInfo 调用次数的 HolidayChecker
+type countingChecker struct {
+	HolidayChecker
+	calls int
+	err   error
+}
+
+func (c *countingChecker) GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.HolidayChecker.GetHolidayInfo(date)
+}
+
+func TestWithMemo(t *testing.T) {
+	inner := &countingChecker{HolidayChecker: NewCheckerWithConfig(Config{DisableRemote: true})}
+	memo := WithMemo(inner, 2)
+
+	holiday := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		isHoliday, name, err := memo.IsHoliday(holiday)
+		if err != nil || !isHoliday || name != "National Day,国庆节,3" {
+			t.Fatalf("IsHoliday() = %v, %q, %v", isHoliday, name, err)
+		}
+	}
+	if inner.calls != 1 {
+		t.Errorf("inner called %d times, want 1", inner.calls)
+	}
+
+	weekend := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	if _, name, _ := memo.IsHoliday(weekend); name != "周末" {
+		t.Errorf("weekend name = %q, want 周末", name)
+	}
+	if dayType, _, _ := memo.Classify(time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)); dayType != DayTypeAdjustedWorkday {
+		t.Errorf("Classify() = %s, want adjusted_workday", dayType)
+	}
+	if memo.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", memo.Len())
+	}
+
+	// 容量为 2，最早使用的 10-01 已被淘汰
+	inner.calls = 0
+	memo.IsWorkday(holiday)
+	if inner.calls != 1 {
+		t.Errorf("evicted date should be fetched again, inner called %d times", inner.calls)
+	}
+
+	memo.Purge()
+	if memo.Len() != 0 {
+		t.Errorf("Len() after Purge = %d", memo.Len())
+	}
+
+	// 错误不缓存
+	inner.err = errors.New("unavailable")
+	if _, err := memo.IsWorkday(holiday); err == nil {
+		t.Error("expected error from inner checker")
+	}
+	inner.err = nil
+	if ok, err := memo.IsWorkday(holiday); err != nil || ok {
+		t.Errorf("IsWorkday() = %v, %v; want false, nil", ok, err)
+	}
+}
+
+func TestMemoOutOfRangeYears(t *testing.T) {
+	inner := &countingChecker{HolidayChecker: NewCheckerWithConfig(Config{DisableRemote: true, HeuristicFallback: true})}
+	memo := WithMemo(inner, 0)
+
+	// 年份超出 0-9999 的日期各自缓存，不会共用同一个键
+	saturday := time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)
+	monday := saturday.AddDate(0, 0, 2)
+	for _, date := range []time.Time{saturday, monday, time.Date(-1, 1, 1, 0, 0, 0, 0, time.UTC)} {
+		want, err := inner.HolidayChecker.IsWorkday(date)
+		if err != nil {
+			t.Fatalf("IsWorkday(%v) failed: %v", date, err)
+		}
+		if got, err := memo.IsWorkday(date); err != nil || got != want {
+			t.Errorf("memo.IsWorkday(%v) = %v, %v, want %v", date, got, err, want)
+		}
+	}
+	if inner.calls != 3 || memo.Len() != 3 {
+		t.Errorf("inner called %d times, Len() = %d, want 3", inner.calls, memo.Len())
+	}
+}
@@ -0,0 +1,242 @@
+package cnholiday
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// festivalEnglishNames 节日的英文名称，与内置数据文件中 "英文名,中文名,法定天数" 的第一段一致
+var festivalEnglishNames = map[Festival]string{
+	FestivalNewYear:        "New Year's Day",
+	FestivalSpringFestival: "Spring Festival",
+	FestivalQingMing:       "Tomb-sweeping Day",
+	FestivalLabourDay:      "Labour Day",
+	FestivalDragonBoat:     "Dragon Boat Festival",
+	FestivalMidAutumn:      "Mid-autumn Festival",
+	FestivalNationalDay:    "National Day",
+}
+
+const noticeFestival = `(?:元旦|春节|清明节?|劳动节|端午节?|中秋节?|国庆节?)`
+
+var (
+	// noticeYearRe 通知标题中的年份，例如 "关于2026年部分节假日安排的通知"
+	noticeYearRe = regexp.MustCompile(`(\d{4})年部分节假日安排`)
+	// noticeHeadingRe 每个节日段落的标题，例如 "一、元旦："、"六、国庆节、中秋节："
+	noticeHeadingRe = regexp.MustCompile(`(?:[一二三四五六七八九十]+、)?(` + noticeFestival + `(?:、` + noticeFestival + `)*)：`)
+	// noticeDateRe 日期，年份和月份可以省略，例如 "2025年12月28日（周日）"、"3日（周六）"
+	noticeDateRe = regexp.MustCompile(`(?:(\d{4})年)?(?:(\d{1,2})月)?(\d{1,2})日(?:（[^）]*）)?`)
+	// noticeRangeRe 放假区间，例如 "1月1日（周四）至3日（周六）放假调休，共3天"、"1月1日放假1天"
+	noticeRangeRe = regexp.MustCompile(noticeDateRe.String() + `(?:至` + noticeDateRe.String() + `)?放假(?:调休)?(?:(\d+)天)?`)
+	// noticeTotalRe 放假总天数，例如 "共8天"
+	noticeTotalRe = regexp.MustCompile(`共(\d+)天`)
+)
+
+// noticeNormalizer 统一全角、半角标点，便于匹配
+var noticeNormalizer = strings.NewReplacer("(", "（", ")", "）", ":", "：", " ", "", "　", "", "\u00a0", "", "\t", "", "\r", "")
+
+// ParseNotice 将国务院办公厅部分节假日安排通知的正文转换为年份数据，便于通知发布后立即生成数据文件
+// 每个节日段落形如 "七、国庆节：10月1日（周四）至7日（周三）放假调休，共7天。9月20日（周日）、10月10日（周六）上班。"，
+// year 为 0 时从标题 "关于2026年部分节假日安排的通知" 中识别年份。
+// 补休日的天数为放假期间的周一至周五天数减去法定天数，法定天数按放假办法的规定，
+// 补休日取不是法定假日的最后几个周一至周五(清明、端午、中秋的农历日期无法推算，视为都不是法定假日)；
+// 合并放假的节日使用连写的名称，例如 "Mid-autumn Festival/National Day,中秋、国庆节,4"。
+// 解析尽力而为，生成的数据应经过 ValidateYear 和人工核对后再发布
+func ParseNotice(year int, text string) (*HolidayData, error) {
+	text = noticeNormalizer.Replace(text)
+	if year == 0 {
+		var err error
+		if year, err = NoticeYear(text); err != nil {
+			return nil, err
+		}
+	}
+
+	headings := noticeHeadingRe.FindAllStringSubmatchIndex(text, -1)
+	if len(headings) == 0 {
+		return nil, errors.New("未找到任何节日的放假安排")
+	}
+
+	data := &HolidayData{
+		Holidays:   make(map[string]string),
+		Workdays:   make(map[string]string),
+		InLieuDays: make(map[string]string),
+	}
+	for i, h := range headings {
+		end := len(text)
+		if i+1 < len(headings) {
+			end = headings[i+1][0]
+		}
+		body := text[h[1]:end]
+		if nl := strings.IndexByte(body, '\n'); nl >= 0 {
+			body = body[:nl] // 段落之后可能是通知的结束语
+		}
+		if err := parseNoticeSection(year, text[h[2]:h[3]], body, data); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// NoticeYear 从通知标题 "关于2026年部分节假日安排的通知" 中识别通知对应的年份
+func NoticeYear(text string) (int, error) {
+	m := noticeYearRe.FindStringSubmatch(noticeNormalizer.Replace(text))
+	if m == nil {
+		return 0, errors.New("未找到通知年份，请指定 year")
+	}
+	year, _ := strconv.Atoi(m[1])
+	return year, nil
+}
+
+// parseNoticeSection 解析一个节日段落，结果写入 data
+func parseNoticeSection(year int, heading, body string, data *HolidayData) error {
+	festivals := NormalizeFestival(heading)
+	english := make([]string, len(festivals))
+	chinese := make([]string, len(festivals))
+	statutory := 0
+	for i, f := range festivals {
+		english[i], chinese[i] = festivalEnglishNames[f], f.String()
+		statutory += statutoryDays(year, f)
+	}
+	name := fmt.Sprintf("%s,%s,%d", strings.Join(english, "/"), strings.Join(chinese, "、"), statutory)
+
+	m := noticeRangeRe.FindStringSubmatch(body)
+	if m == nil {
+		return fmt.Errorf("%s: 未找到放假日期", heading)
+	}
+	start, err := noticeDate(year, 0, m[1], m[2], m[3])
+	if err != nil {
+		return fmt.Errorf("%s: %w", heading, err)
+	}
+	last := start
+	if m[6] != "" {
+		if last, err = noticeDate(year, start.Month(), m[4], m[5], m[6]); err != nil {
+			return fmt.Errorf("%s: %w", heading, err)
+		}
+		if m[4] == "" && m[5] == "" && last.Before(start) {
+			last = last.AddDate(0, 1, 0) // "12月31日至2日" 这类省略月份的跨月区间
+		}
+	}
+	if last.Before(start) {
+		return fmt.Errorf("%s: 放假结束日期早于开始日期", heading)
+	}
+
+	days := daysBetween(start, last) + 1
+	total := m[7]
+	if t := noticeTotalRe.FindStringSubmatch(body); t != nil {
+		total = t[1]
+	}
+	if total != "" {
+		if n, _ := strconv.Atoi(total); n != days {
+			return fmt.Errorf("%s: 放假 %s 至 %s 为 %d 天，与通知中的共 %s 天不符",
+				heading, start.Format("2006-01-02"), last.Format("2006-01-02"), days, total)
+		}
+	}
+
+	// 放假期间的周一至周五超出法定天数的部分是补休日，按惯例从不是法定假日的周一至周五中取最后几个
+	weekdays := 0
+	var candidates []time.Time
+	for day := start; !day.After(last); day = day.AddDate(0, 0, 1) {
+		data.Holidays[day.Format("2006-01-02")] = name
+		if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			continue
+		}
+		weekdays++
+		if !isStatutoryDate(festivals, day) {
+			candidates = append(candidates, day)
+		}
+	}
+	if extra := min(weekdays-statutory, len(candidates)); extra > 0 {
+		for _, day := range candidates[len(candidates)-extra:] {
+			data.InLieuDays[day.Format("2006-01-02")] = name
+		}
+	}
+
+	for _, sentence := range strings.FieldsFunc(body, func(r rune) bool { return r == '。' || r == '；' }) {
+		if !strings.Contains(sentence, "上班") || strings.Contains(sentence, "放假") {
+			continue
+		}
+		month := time.Month(0)
+		for _, d := range noticeDateRe.FindAllStringSubmatch(sentence, -1) {
+			day, err := noticeDate(year, month, d[1], d[2], d[3])
+			if err != nil {
+				return fmt.Errorf("%s: %w", heading, err)
+			}
+			month = day.Month()
+			data.Workdays[day.Format("2006-01-02")] = name
+		}
+	}
+	return nil
+}
+
+// isStatutoryDate 判断 day 是否是 festivals 中某个节日的法定假日，各节日的法定天数按放假办法的规定
+func isStatutoryDate(festivals []Festival, day time.Time) bool {
+	for _, f := range festivals {
+		if isStatutoryDateOf(f, day, statutoryDays(day.Year(), f)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStatutoryDateOf 判断 day 是否是节日 f 从法定首日起的 n 天法定假日之一
+func isStatutoryDateOf(f Festival, day time.Time, n int) bool {
+	first, ok := statutoryFirstDay(day.Year(), f)
+	if !ok {
+		return false
+	}
+	offset := daysBetween(first, day)
+	return offset >= 0 && offset < n
+}
+
+// statutoryFirstDay 返回 year 年节日 f 的第一个法定假日：元旦、劳动节、国庆节按公历日期，
+// 清明按节气推算，春节、端午、中秋按内置的农历日期表，超出日期表范围时返回 false
+func statutoryFirstDay(year int, f Festival) (time.Time, bool) {
+	switch f {
+	case FestivalNewYear:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local), true
+	case FestivalLabourDay:
+		return time.Date(year, time.May, 1, 0, 0, 0, 0, time.Local), true
+	case FestivalNationalDay:
+		return time.Date(year, time.October, 1, 0, 0, 0, 0, time.Local), true
+	case FestivalQingMing:
+		return qingMing(year)
+	case FestivalDragonBoat:
+		return lunarTableDate(dragonBoatDates[:], year)
+	case FestivalMidAutumn:
+		return lunarTableDate(midAutumnDates[:], year)
+	case FestivalSpringFestival:
+		// 2025 年起法定假日为除夕至正月初三，此前为正月初一至初三
+		first, err := SpringFestival(year)
+		if err != nil {
+			return time.Time{}, false
+		}
+		if year >= 2025 {
+			first = first.AddDate(0, 0, -1)
+		}
+		return first, true
+	}
+	return time.Time{}, false
+}
+
+// noticeDate 解析通知中的日期，省略年份时为通知年份，省略月份时沿用 month
+func noticeDate(year int, month time.Month, y, m, d string) (time.Time, error) {
+	if y != "" {
+		year, _ = strconv.Atoi(y)
+	}
+	if m != "" {
+		n, _ := strconv.Atoi(m)
+		month = time.Month(n)
+	}
+	day, _ := strconv.Atoi(d)
+	if month < time.January || month > time.December {
+		return time.Time{}, fmt.Errorf("日期缺少月份: %s日", d)
+	}
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	if date.Day() != day {
+		return time.Time{}, fmt.Errorf("日期无效: %d月%d日", month, day)
+	}
+	return date, nil
+}
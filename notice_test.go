@@ -0,0 +1,111 @@
+package cnholiday
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNotice(t *testing.T) {
+	text, err := os.ReadFile("testdata/notice/2026.txt")
+	if err != nil {
+		t.Fatalf("read notice: %v", err)
+	}
+	got, err := ParseNotice(0, string(text))
+	if err != nil {
+		t.Fatalf("ParseNotice failed: %v", err)
+	}
+
+	raw, err := embeddedData.ReadFile("data/2026.json")
+	if err != nil {
+		t.Fatalf("read embedded 2026: %v", err)
+	}
+	want, err := parseHolidayData(raw)
+	if err != nil {
+		t.Fatalf("parse embedded 2026: %v", err)
+	}
+	// 由通知生成的数据应与内置数据逐项一致
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseNotice(2026) mismatch\ngot:  %v\nwant: %v", got, want)
+	}
+}
+
+func TestParseNoticeOverlapping(t *testing.T) {
+	text, err := os.ReadFile("testdata/notice/2025.txt")
+	if err != nil {
+		t.Fatalf("read notice: %v", err)
+	}
+	got, err := ParseNotice(2025, string(text))
+	if err != nil {
+		t.Fatalf("ParseNotice failed: %v", err)
+	}
+
+	// 内置数据单独标注了中秋当天，通知中只有合并的名称，比较日期类型而不比较名称
+	raw, err := embeddedData.ReadFile("data/2025.json")
+	if err != nil {
+		t.Fatalf("read embedded 2025: %v", err)
+	}
+	want, err := parseHolidayData(raw)
+	if err != nil {
+		t.Fatalf("parse embedded 2025: %v", err)
+	}
+	if newYearIndex(2025, got).types != newYearIndex(2025, want).types {
+		t.Errorf("ParseNotice(2025) day types differ from embedded data\ngot:  %v\nwant: %v", got, want)
+	}
+	if name := got.Holidays["2025-10-06"]; name != "Mid-autumn Festival/National Day,中秋、国庆节,4" {
+		t.Errorf("2025-10-06 name = %q", name)
+	}
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	if err := ValidateYear(2025, data); err != nil {
+		t.Errorf("ValidateYear failed: %v", err)
+	}
+}
+
+func TestParseNoticeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		text string
+		want string
+	}{
+		{"no year", 0, "一、元旦：1月1日放假，共1天。", "未找到通知年份"},
+		{"no sections", 2030, "关于2030年部分节假日安排的通知", "未找到任何节日"},
+		{"no range", 2030, "一、元旦：另行通知。", "未找到放假日期"},
+		{"total mismatch", 2030, "七、国庆节：10月1日至7日放假调休，共8天。", "与通知中的共 8 天不符"},
+		{"invalid date", 2030, "一、元旦：2月30日放假，共1天。", "日期无效"},
+	}
+	for _, tt := range tests {
+		_, err := ParseNotice(tt.year, tt.text)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: ParseNotice error = %v, want containing %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestParseNoticeCrossYear(t *testing.T) {
+	text := "一、元旦：2029年12月30日（周日）至2030年1月1日（周二）放假调休，共3天。2029年12月29日（周六）上班。"
+	got, err := ParseNotice(2030, text)
+	if err != nil {
+		t.Fatalf("ParseNotice failed: %v", err)
+	}
+	if _, ok := got.Workdays["2029-12-29"]; !ok {
+		t.Errorf("Workdays = %v, want 2029-12-29", got.Workdays)
+	}
+	if len(got.Holidays) != 3 || got.InLieuDays["2029-12-31"] == "" {
+		t.Errorf("Holidays = %v, InLieuDays = %v", got.Holidays, got.InLieuDays)
+	}
+}
+
+func TestNoticeYear(t *testing.T) {
+	if year, err := NoticeYear("国务院办公厅关于2027年部分节假日安排的通知"); err != nil || year != 2027 {
+		t.Errorf("NoticeYear = %d, %v, want 2027", year, err)
+	}
+	if _, err := NoticeYear("节假日安排"); err == nil {
+		t.Error("NoticeYear without title should fail")
+	}
+}
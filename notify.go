@@ -0,0 +1,69 @@
+package cnholiday
+
+import (
+	"sort"
+	"time"
+)
+
+// ChangeEvent 年份数据刷新后发生变化时发出的事件
+type ChangeEvent struct {
+	Year    int         `json:"year"`
+	Version int         `json:"version"` // 数据版本，即变更记录序号 Amendment.Seq
+	Source  Source      `json:"source"`
+	Time    time.Time   `json:"time"`
+	Changes []DayChange `json:"changes"`
+}
+
+// Subscribe 订阅数据变更，返回取消订阅的函数
+// 仅在已有数据被重新加载、修订或导入且内容发生变化时通知，首次加载不会通知(StartPolling 发现的年份除外)。
+// 回调在触发变更的 goroutine 中同步执行，耗时操作应自行异步处理；EventPublisher、WebhookNotifier 的 Handle 自带有界队列，可直接订阅
+func (c *Checker) Subscribe(fn func(ChangeEvent)) (cancel func()) {
+	c.subMu.Lock()
+	defer c.subMu.Unlock()
+
+	if c.subscribers == nil {
+		c.subscribers = make(map[int]func(ChangeEvent))
+	}
+	id := c.nextSubID
+	c.nextSubID++
+	c.subscribers[id] = fn
+
+	return func() {
+		c.subMu.Lock()
+		delete(c.subscribers, id)
+		c.subMu.Unlock()
+	}
+}
+
+// notifyChange 向订阅者发送变更事件，调用方不能持有 c.mu
+func (c *Checker) notifyChange(year int, amendment Amendment) {
+	if amendment.Seq <= 1 || len(amendment.Changes) == 0 {
+		return
+	}
+	c.publishChange(year, amendment)
+}
+
+// publishChange 向订阅者发送变更事件，不检查是否为首次加载，调用方不能持有 c.mu
+func (c *Checker) publishChange(year int, amendment Amendment) {
+	c.subMu.Lock()
+	ids := make([]int, 0, len(c.subscribers))
+	for id := range c.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	subscribers := make([]func(ChangeEvent), len(ids))
+	for i, id := range ids {
+		subscribers[i] = c.subscribers[id]
+	}
+	c.subMu.Unlock()
+
+	for _, fn := range subscribers {
+		fn(ChangeEvent{
+			Year:    year,
+			Version: amendment.Seq,
+			Source:  amendment.Source,
+			Time:    amendment.Time,
+			Changes: append([]DayChange(nil), amendment.Changes...),
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package cnholiday
+
+import "testing"
+
+func TestSubscribe(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var events []ChangeEvent
+	cancel := checker.Subscribe(func(e ChangeEvent) { events = append(events, e) })
+
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("initial load should not notify, got %d events", len(events))
+	}
+
+	// 重新加载相同数据不通知，也不产生新的版本
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("unchanged reload should not notify, got %d events", len(events))
+	}
+
+	if _, err := checker.PatchYear(2026, &HolidayData{Workdays: map[string]string{"2026-10-10": ""}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	e := events[0]
+	if e.Year != 2026 || e.Version != 2 || e.Source != SourcePatch || len(e.Changes) != 1 {
+		t.Errorf("event = %+v", e)
+	}
+
+	// 重新加载恢复原始数据，同样是一次变更
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	if len(events) != 2 || events[1].Source != SourceEmbedded {
+		t.Fatalf("reload after patch should notify, got %+v", events)
+	}
+
+	cancel()
+	if _, err := checker.PatchYear(2026, &HolidayData{Workdays: map[string]string{"2026-10-10": ""}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	if len(events) != 2 {
+		t.Errorf("cancelled subscriber received %d events", len(events))
+	}
+}
@@ -0,0 +1,85 @@
+package cnholiday
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// StandardDailyHours 标准工作日的每日工作时长
+const StandardDailyHours = 8
+
+// WorkEntry 一条工时记录，同一天的多条记录会合并计算
+type WorkEntry struct {
+	Date  time.Time
+	Hours float64
+}
+
+// Overtime 按《劳动法》第四十四条分类的工时
+type Overtime struct {
+	Normal      float64 // 工作日标准工时内
+	Overtime150 float64 // 工作日延长工时，不低于 150%
+	Overtime200 float64 // 休息日加班，不低于 200%
+	Overtime300 float64 // 法定节假日加班，不低于 300%
+}
+
+// Cost 按小时工资计算总工资
+func (o *Overtime) Cost(hourlyRate float64) float64 {
+	return hourlyRate * (o.Normal + 1.5*o.Overtime150 + 2*o.Overtime200 + 3*o.Overtime300)
+}
+
+// OvertimeBreakdown 按日期类型将工时划分为标准工时及各倍率加班工时
+// 工作日(含调休工作日)超过 StandardDailyHours 的部分计入 150%，
+// 周末、补休日等休息日计入 200%，法定节假日计入 300%
+func (c *Checker) OvertimeBreakdown(entries []WorkEntry) (*Overtime, error) {
+	type day struct {
+		date  time.Time
+		hours float64
+	}
+	days := make(map[int]*day) // 按 dayNumber 合并同一天的记录
+	for _, e := range entries {
+		if e.Hours < 0 {
+			return nil, fmt.Errorf("工时不能为负数: %s %.2f", e.Date.Format("2006-01-02"), e.Hours)
+		}
+		key := dayNumber(e.Date)
+		if d, ok := days[key]; ok {
+			d.hours += e.Hours
+		} else {
+			days[key] = &day{date: e.Date, hours: e.Hours}
+		}
+	}
+
+	keys := make([]int, 0, len(days))
+	for key := range days {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	result := &Overtime{}
+	for _, key := range keys {
+		d := days[key]
+		if d.hours > 24 {
+			return nil, fmt.Errorf("单日工时超过 24 小时: %s %.2f", d.date.Format("2006-01-02"), d.hours)
+		}
+
+		dayType, name, err := c.Classify(d.date)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case dayType.IsWorkday():
+			if d.hours > StandardDailyHours {
+				result.Normal += StandardDailyHours
+				result.Overtime150 += d.hours - StandardDailyHours
+			} else {
+				result.Normal += d.hours
+			}
+		case isStatutoryHoliday(dayType, name, d.date):
+			result.Overtime300 += d.hours
+		default:
+			result.Overtime200 += d.hours
+		}
+	}
+	return result, nil
+}
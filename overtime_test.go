@@ -0,0 +1,48 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOvertimeBreakdown(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+
+	entries := []WorkEntry{
+		{day("2026-10-12"), 6}, // 工作日
+		{day("2026-10-12"), 4}, // 同一天，合计 10 小时
+		{day("2026-10-10"), 9}, // 调休工作日
+		{day("2026-10-17"), 5}, // 周末
+		{day("2026-10-07"), 3}, // 补休日
+		{day("2026-10-01"), 8}, // 法定节假日
+		{day("2026-10-03"), 2}, // 落在周六的法定节假日
+		{day("2026-10-05"), 4}, // 法定节假日落在周末顺延的放假日
+	}
+
+	got, err := checker.OvertimeBreakdown(entries)
+	if err != nil {
+		t.Fatalf("OvertimeBreakdown failed: %v", err)
+	}
+	want := Overtime{Normal: 16, Overtime150: 3, Overtime200: 12, Overtime300: 10}
+	if *got != want {
+		t.Errorf("OvertimeBreakdown() = %+v, want %+v", *got, want)
+	}
+	if cost := got.Cost(10); cost != 10*(16+4.5+24+30) {
+		t.Errorf("Cost(10) = %v", cost)
+	}
+
+	if _, err := checker.OvertimeBreakdown([]WorkEntry{{day("2026-10-12"), -1}}); err == nil {
+		t.Error("expected error for negative hours")
+	}
+	if _, err := checker.OvertimeBreakdown([]WorkEntry{{day("2026-10-12"), 20}, {day("2026-10-12"), 5}}); err == nil {
+		t.Error("expected error for more than 24 hours per day")
+	}
+}
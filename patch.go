@@ -0,0 +1,124 @@
+package cnholiday
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+// DayChange 单日的变更
+type DayChange struct {
+	Date       time.Time `json:"date"`
+	Before     DayType   `json:"before"`
+	BeforeName string    `json:"beforeName,omitempty"`
+	After      DayType   `json:"after"`
+	AfterName  string    `json:"afterName,omitempty"`
+}
+
+func (d DayChange) String() string {
+	return fmt.Sprintf("%s: %s(%s) -> %s(%s)", d.Date.Format("2006-01-02"),
+		d.Before.label(), d.BeforeName, d.After.label(), d.AfterName)
+}
+
+// PatchYear 将修订合并到已加载的年份数据中，而不是整体替换，返回实际发生变化的日期
+// patch 中各字段的含义与数据文件相同；名称为空字符串表示从该分类中删除这一天。
+// 年份未加载时会先加载。patch 中的日期必须属于 year 年，调休工作日与数据文件一样也可以属于相邻年份，
+// 与正常加载一样合并到相邻年份中；返回值只包含 year 年的变化
+func (c *Checker) PatchYear(year int, patch *HolidayData) ([]DayChange, error) {
+	// 按固定顺序校验，多处错误时总是报告同一个
+	for _, field := range []struct {
+		name    string
+		entries map[string]string
+	}{
+		{"holidays", patch.Holidays},
+		{"workdays", patch.Workdays},
+		{"inLieuDays", patch.InLieuDays},
+	} {
+		for _, date := range sortedKeys(field.entries) {
+			d, err := time.Parse("2006-01-02", date)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: 日期格式无效", field.name, date)
+			}
+			if d.Year() != year && (field.name != "workdays" || !isAdjacentYear(year, date)) {
+				return nil, fmt.Errorf("%s.%s: 日期不属于 %d 年", field.name, date, year)
+			}
+		}
+	}
+
+	if _, err := c.index(year); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	before := c.cache[year]
+	if before == nil {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("%d 年数据已被清除", year)
+	}
+
+	data := newYearData(before.base.view())
+	applyPatch(data.holidays, patch.Holidays)
+	applyPatch(data.workdays, patch.Workdays)
+	applyPatch(data.inLieuDays, patch.InLieuDays)
+	now := time.Now()
+	spilled := c.recordCrossYear(year, data, SourcePatch, now)
+	after := c.buildIndex(year, data)
+
+	c.cache[year] = after
+	c.cacheGen.Add(1)
+	amendment := c.appendHistory(year, SourcePatch, after, now)
+	c.mu.Unlock()
+
+	for _, a := range spilled {
+		c.notifyChange(a.year, a.amendment)
+	}
+	c.notifyChange(year, amendment)
+	return diffYearIndex(before, after), nil
+}
+
+// cloneHolidayData 深拷贝年份数据，结果中的各个分类都不为 nil
+func cloneHolidayData(data *HolidayData) *HolidayData {
+	clone := func(m map[string]string) map[string]string {
+		if m == nil {
+			return make(map[string]string)
+		}
+		return maps.Clone(m)
+	}
+	return &HolidayData{
+		Holidays:   clone(data.Holidays),
+		Workdays:   clone(data.Workdays),
+		InLieuDays: clone(data.InLieuDays),
+	}
+}
+
+// applyPatch 合并单个分类，空名称表示删除
+func applyPatch(dst, patch map[string]string) {
+	for date, name := range patch {
+		if name == "" {
+			delete(dst, date)
+		} else {
+			dst[date] = name
+		}
+	}
+}
+
+// diffYearIndex 比较同一年份的两个索引，返回按日期排序的变更
+func diffYearIndex(before, after *yearIndex) []DayChange {
+	var changes []DayChange
+	day := time.Date(before.year, 1, 1, 0, 0, 0, 0, time.UTC)
+	for day.Year() == before.year {
+		bt, bn := before.lookup(day)
+		at, an := after.lookup(day)
+		if bt != at || bn != an {
+			changes = append(changes, DayChange{
+				Date:       day,
+				Before:     bt,
+				BeforeName: bn,
+				After:      at,
+				AfterName:  an,
+			})
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return changes
+}
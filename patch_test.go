@@ -0,0 +1,68 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPatchYear(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	// 假设国务院临时调整：10 月 8 日补休，10 月 10 日不再调休上班
+	changes, err := checker.PatchYear(2026, &HolidayData{
+		Holidays:   map[string]string{"2026-10-08": "National Day,国庆节,3"},
+		Workdays:   map[string]string{"2026-10-10": ""},
+		InLieuDays: map[string]string{"2026-10-08": "National Day,国庆节,3"},
+	})
+	if err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+
+	if len(changes) != 2 {
+		t.Fatalf("len(changes) = %d, want 2: %v", len(changes), changes)
+	}
+	if changes[0].Date.Format("2006-01-02") != "2026-10-08" || changes[0].Before != DayTypeWorkday || changes[0].After != DayTypeInLieu {
+		t.Errorf("changes[0] = %v", changes[0])
+	}
+	if changes[1].Date.Format("2006-01-02") != "2026-10-10" || changes[1].Before != DayTypeAdjustedWorkday || changes[1].After != DayTypeWeekend {
+		t.Errorf("changes[1] = %v", changes[1])
+	}
+	if got := changes[0].String(); got != "2026-10-08: 工作日() -> 补休日(National Day,国庆节,3)" {
+		t.Errorf("String() = %q", got)
+	}
+
+	// 未修订的日期保持不变
+	isHoliday, _, _ := checker.IsHoliday(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	if !isHoliday {
+		t.Error("unpatched holiday should be kept")
+	}
+	isHoliday, _, _ = checker.IsHoliday(time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC))
+	if !isHoliday {
+		t.Error("patched date should be a holiday")
+	}
+
+	// 重复应用无变化
+	changes, err = checker.PatchYear(2026, &HolidayData{Workdays: map[string]string{"2026-10-10": ""}})
+	if err != nil || len(changes) != 0 {
+		t.Errorf("idempotent patch = %v, %v", changes, err)
+	}
+
+	if _, err := checker.PatchYear(2026, &HolidayData{Holidays: map[string]string{"2025-10-01": "国庆节"}}); err == nil {
+		t.Error("expected error for date outside the year")
+	}
+
+	// 多处错误时总是报告字段顺序、日期顺序中的第一个
+	invalid := &HolidayData{
+		Holidays: map[string]string{"2025-12-31": "x", "2025-01-01": "x", "bad": "x"},
+		Workdays: map[string]string{"2027-01-01": "x"},
+	}
+	for range 5 {
+		_, err := checker.PatchYear(2026, invalid)
+		if err == nil || err.Error() != "holidays.2025-01-01: 日期不属于 2026 年" {
+			t.Fatalf("PatchYear error = %v", err)
+		}
+	}
+}
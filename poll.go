@@ -0,0 +1,139 @@
+package cnholiday
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// htmlHiddenRe 页面中不显示的脚本和样式
+	htmlHiddenRe = regexp.MustCompile(`(?is)<(?:script|style)\b.*?</(?:script|style)\s*>`)
+	// htmlBreakRe 换行和段落结束标签，转换为换行以保留通知的段落结构
+	htmlBreakRe = regexp.MustCompile(`(?i)<br\s*/?>|</(?:p|div|li|h\d)\s*>`)
+	// htmlTagRe 其余标签
+	htmlTagRe = regexp.MustCompile(`<[^>]*>`)
+)
+
+// StartPolling 在后台定期检查 Config.PollURLs，发现 years 的放假安排后加载并通知订阅者，
+// 国务院每年年底发布次年安排后无需重新发布或重启服务即可生效。years 为空时每次检查当前时间的下一年。
+// 已缓存的年份不再检查；与 Subscribe 的约定不同，轮询发现的年份即使是首次加载也会通知订阅者。
+// 立即检查一次，之后每隔 Config.PollInterval 检查一次，ctx 取消或 Close 后停止
+func (c *Checker) StartPolling(ctx context.Context, years ...int) error {
+	if len(c.settings().PollURLs) == 0 {
+		return errors.New("未配置 PollURLs")
+	}
+
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return errors.New("检查器已关闭")
+	}
+	c.background.Add(1) // 持有 mu 时登记，保证 Close 等待时不会再有新的后台任务
+	c.mu.Unlock()
+
+	// Close 时同样停止轮询
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(c.lifetime, cancel)
+	years = append([]int(nil), years...)
+
+	go func() {
+		defer c.background.Done()
+		defer stop()
+		defer cancel()
+
+		ticker := time.NewTicker(c.settings().PollInterval)
+		defer ticker.Stop()
+		for {
+			c.pollYears(ctx, years)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return nil
+}
+
+// pollYears 检查一轮尚未缓存的年份，每个年份按顺序尝试 PollURLs，直到某个地址发布了该年份的安排
+func (c *Checker) pollYears(ctx context.Context, years []int) {
+	if len(years) == 0 {
+		years = []int{time.Now().Year() + 1}
+	}
+	config := c.settings()
+	for _, year := range years {
+		if c.IsYearLoaded(year) {
+			continue
+		}
+		for _, raw := range config.PollURLs {
+			url := strings.ReplaceAll(raw, yearPlaceholder, strconv.Itoa(year))
+			found, err := c.pollURL(ctx, year, url)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				if config.OnPollError != nil {
+					config.OnPollError(year, url, err)
+				}
+				continue
+			}
+			if found {
+				break
+			}
+		}
+	}
+}
+
+// pollURL 检查地址是否已发布 year 年的放假安排，已发布时加载并通知订阅者
+// 返回 false 且 err 为 nil 表示尚未发布
+func (c *Checker) pollURL(ctx context.Context, year int, url string) (bool, error) {
+	start := time.Now()
+	body, err := c.fetch(ctx, url, nil)
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	source := SourceRemote
+	var data *HolidayData
+	if body = bytes.TrimSpace(body); len(body) > 0 && body[0] == '{' {
+		data, err = c.parseYear(SourceRemote, year, body)
+	} else {
+		text := noticeText(body)
+		if noticeYear, err := NoticeYear(text); err != nil || noticeYear != year {
+			return false, nil // 页面中还没有该年份的通知
+		}
+		source = SourceNotice
+		data, err = ParseNotice(year, text)
+	}
+	if err != nil {
+		return false, err
+	}
+	if len(data.Holidays) == 0 {
+		return false, nil // 部分镜像会为未发布的年份提供空数据
+	}
+
+	attempts := []LoadAttempt{{Source: source, Start: start, Duration: time.Since(start)}}
+	if amendment := c.store(year, data, source, attempts); amendment.Seq == 1 && len(amendment.Changes) > 0 {
+		c.publishChange(year, amendment)
+	}
+	return true, nil
+}
+
+// noticeText 提取通知页面的正文，去掉 HTML 标签并保留段落的换行
+func noticeText(body []byte) string {
+	text := htmlHiddenRe.ReplaceAllString(string(body), "")
+	text = htmlBreakRe.ReplaceAllString(text, "\n")
+	text = htmlTagRe.ReplaceAllString(text, "")
+	return html.UnescapeString(text)
+}
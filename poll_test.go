@@ -0,0 +1,158 @@
+package cnholiday
+
+import (
+	"context"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStartPollingNotice(t *testing.T) {
+	notice, err := os.ReadFile("testdata/notice/2026.txt")
+	if err != nil {
+		t.Fatalf("read notice: %v", err)
+	}
+	var page strings.Builder
+	page.WriteString("<html><head><style>p { margin: 0 }</style></head><body>")
+	for _, line := range strings.Split(string(notice), "\n") {
+		page.WriteString("<p>" + html.EscapeString(line) + "</p>")
+	}
+	page.WriteString("</body></html>")
+
+	// 第一次检查时通知尚未发布
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(page.String()))
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		DisableRemote: true,
+		PollURLs:      []string{server.URL + "/notice"},
+		PollInterval:  10 * time.Millisecond,
+		OnPollError:   func(year int, url string, err error) { t.Errorf("OnPollError(%d, %s): %v", year, url, err) },
+	})
+	defer checker.Close()
+	changes := make(chan ChangeEvent, 1)
+	checker.Subscribe(func(e ChangeEvent) { changes <- e })
+
+	if err := checker.StartPolling(context.Background(), 2026); err != nil {
+		t.Fatalf("StartPolling failed: %v", err)
+	}
+	select {
+	case e := <-changes:
+		if e.Year != 2026 || e.Source != SourceNotice || e.Version != 1 || len(e.Changes) == 0 {
+			t.Errorf("change event = %+v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscribers should be notified when the notice is published")
+	}
+
+	if report, _ := checker.LoadReport(2026); report.Source != SourceNotice {
+		t.Errorf("source = %s, want notice", report.Source)
+	}
+	date := time.Date(2026, 10, 7, 0, 0, 0, 0, time.Local)
+	if inLieu, err := checker.IsInLieuDay(date); err != nil || !inLieu {
+		t.Errorf("IsInLieuDay(2026-10-07) = %v, %v", inLieu, err)
+	}
+
+	// 已缓存的年份不再检查
+	time.Sleep(50 * time.Millisecond)
+	if n := requests.Load(); n != 2 {
+		t.Errorf("requests = %d, want 2", n)
+	}
+}
+
+func TestStartPollingJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/news":
+			w.Write([]byte("<html><body><p>关于2029年部分节假日安排的通知</p></body></html>"))
+		case "/mirror/2030.json":
+			w.Write([]byte(`{"holidays": {"2030-10-01": "国庆节"}, "workdays": {}, "inLieuDays": {}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		DisableRemote: true,
+		PollURLs:      []string{server.URL + "/news", server.URL + "/mirror/{year}.json"},
+		PollInterval:  time.Hour,
+	})
+	changes := make(chan ChangeEvent, 1)
+	checker.Subscribe(func(e ChangeEvent) { changes <- e })
+
+	if err := checker.StartPolling(context.Background(), 2030); err != nil {
+		t.Fatalf("StartPolling failed: %v", err)
+	}
+	select {
+	case e := <-changes:
+		if e.Year != 2030 || e.Source != SourceRemote {
+			t.Errorf("change event = %+v", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscribers should be notified when the mirror publishes the year")
+	}
+	checker.Close()
+
+	if isHoliday, _, err := checker.IsHoliday(time.Date(2030, 10, 1, 0, 0, 0, 0, time.Local)); err != nil || !isHoliday {
+		t.Errorf("IsHoliday(2030-10-01) = %v, %v", isHoliday, err)
+	}
+	if err := checker.StartPolling(context.Background()); err == nil {
+		t.Error("expected error after Close")
+	}
+}
+
+func TestStartPollingErrors(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.StartPolling(context.Background()); err == nil {
+		t.Error("expected error without PollURLs")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	errs := make(chan error, 1)
+	checker = NewCheckerWithConfig(Config{
+		DisableRemote: true,
+		PollURLs:      []string{server.URL},
+		PollInterval:  time.Hour,
+		OnPollError: func(year int, url string, err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := checker.StartPolling(ctx, 2030); err != nil {
+		t.Fatalf("StartPolling failed: %v", err)
+	}
+	select {
+	case err := <-errs:
+		if err.Error() != "HTTP 状态码 500" {
+			t.Errorf("OnPollError err = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnPollError should be called")
+	}
+	cancel()
+	checker.background.Wait()
+
+	if err := (Config{PollURLs: []string{"ftp://example.com/{year}"}, DisableRemote: true}).Validate(); err == nil {
+		t.Error("expected validation error for non-http PollURLs")
+	}
+}
@@ -0,0 +1,67 @@
+package cnholiday
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+)
+
+// LoadYears 并发加载多个年份的节假日数据
+// 并发数由 Config.LoadConcurrency 控制，重复的年份只加载一次。
+// 任一年份失败时返回 *LoadYearsError，其中记录了每个失败年份在各数据源的错误；
+// 其余年份照常加载，不会因个别年份失败而中断。
+// 等待并发名额时 ctx 被取消则不再启动剩余年份，直接返回 ctx.Err()
+func (c *Checker) LoadYears(ctx context.Context, years ...int) error {
+	unique := make([]int, 0, len(years))
+	seen := make(map[int]bool, len(years))
+	for _, year := range years {
+		if !seen[year] {
+			seen[year] = true
+			unique = append(unique, year)
+		}
+	}
+
+	var (
+		mu     sync.Mutex
+		failed []*YearLoadError
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, c.settings().LoadConcurrency)
+	)
+
+	for _, year := range unique {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			// 不再启动新的加载，等已启动的结束后返回
+			wg.Wait()
+			return ctx.Err()
+		}
+		wg.Add(1)
+		go func(year int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := c.LoadYearContext(ctx, year)
+			if err == nil {
+				return
+			}
+			var yearErr *YearLoadError
+			if !errors.As(err, &yearErr) {
+				yearErr = &YearLoadError{Year: year, Errors: []*SourceError{{Err: err}}}
+			}
+			mu.Lock()
+			failed = append(failed, yearErr)
+			mu.Unlock()
+		}(year)
+	}
+	wg.Wait()
+
+	if len(failed) == 0 {
+		return nil
+	}
+	sort.Slice(failed, func(i, j int) bool { return failed[i].Year < failed[j].Year })
+	return &LoadYearsError{Errors: failed}
+}
@@ -0,0 +1,243 @@
+package cnholiday
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLoadYears(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "2030.json"), []byte(emptyYearJSON), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	checker := NewCheckerWithConfig(Config{
+		LocalDataDir:  tmpDir,
+		DisableRemote: true,
+	})
+
+	err := checker.LoadYears(context.Background(), 2026, 2030, 2099, 2098, 2026)
+	var loadErr *LoadYearsError
+	if !errors.As(err, &loadErr) {
+		t.Fatalf("expected *LoadYearsError, got %v", err)
+	}
+	if got := loadErr.FailedYears(); !reflect.DeepEqual(got, []int{2098, 2099}) {
+		t.Errorf("FailedYears() = %v, want [2098 2099]", got)
+	}
+
+	sources := make([]Source, 0)
+	for _, srcErr := range loadErr.Errors[0].Errors {
+		sources = append(sources, srcErr.Source)
+	}
+	if !reflect.DeepEqual(sources, []Source{SourceLocal, SourceEmbedded}) {
+		t.Errorf("failed sources = %v, want [local embedded]", sources)
+	}
+
+	for _, year := range []int{2026, 2030} {
+		if !checker.IsYearLoaded(year) {
+			t.Errorf("Year %d should be loaded", year)
+		}
+	}
+}
+
+func TestLoadYearsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(emptyYearJSON))
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:      server.URL,
+		LoadConcurrency: 2,
+	})
+
+	years := []int{2030, 2031, 2032, 2033, 2034, 2035}
+	if err := checker.LoadYears(context.Background(), years...); err != nil {
+		t.Fatalf("LoadYears failed: %v", err)
+	}
+	if max := atomic.LoadInt32(&maxInFlight); max > 2 {
+		t.Errorf("max concurrent requests = %d, want <= 2", max)
+	}
+	for _, year := range years {
+		if !checker.IsYearLoaded(year) {
+			t.Errorf("Year %d should be loaded", year)
+		}
+	}
+}
+
+func TestLoadYearsCanceled(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := checker.LoadYears(ctx, 2026)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if checker.IsYearLoaded(2026) {
+		t.Error("Year should not be loaded with canceled context")
+	}
+}
+
+func TestLoadYearsCanceledWhileWaiting(t *testing.T) {
+	var requests int32
+	started := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		started <- struct{}{}
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:      server.URL,
+		LoadConcurrency: 1,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	// 第一个年份占住唯一的并发名额，取消后其余年份不应再发起请求
+	err := checker.LoadYears(ctx, 2030, 2031, 2032)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("requests = %d, want 1", n)
+	}
+}
+
+func TestYearLoadErrorMessage(t *testing.T) {
+	err := &YearLoadError{
+		Year: 2026,
+		Errors: []*SourceError{
+			{Source: SourceRemote, Err: errors.New("HTTP 状态码 404")},
+			{Source: SourceEmbedded, Err: errors.New("嵌入文件中不存在")},
+		},
+	}
+	want := "无法加载 2026 年的节假日数据: 远程加载失败: HTTP 状态码 404; 嵌入数据加载失败: 嵌入文件中不存在"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	empty := &YearLoadError{Year: 2026}
+	if empty.Error() != "无法加载 2026 年的节假日数据: 未配置数据源" {
+		t.Errorf("unexpected message for empty error: %q", empty.Error())
+	}
+}
+
+func TestEnsureYearLoadedShared(t *testing.T) {
+	release := make(chan struct{})
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(`{"holidays": {"2030-10-01": "国庆节"}, "workdays": {}, "inLieuDays": {}}`))
+	}))
+	defer server.Close()
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL})
+
+	// 已取消的调用方立即返回，不影响其他调用方共享的加载
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error, 1)
+	go func() { canceled <- checker.EnsureYearLoaded(ctx, 2030) }()
+
+	results := make(chan error, 5)
+	for range 5 {
+		go func() {
+			_, err := checker.IsWorkday(time.Date(2030, 10, 1, 0, 0, 0, 0, time.Local))
+			results <- err
+		}()
+	}
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	if err := <-canceled; !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled EnsureYearLoaded error = %v, want context.Canceled", err)
+	}
+
+	close(release)
+	for range 5 {
+		if err := <-results; err != nil {
+			t.Errorf("IsWorkday failed: %v", err)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("requests = %d, want 1", n)
+	}
+	if report, _ := checker.LoadReport(2030); report.Source != SourceRemote || report.Err != nil {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestEnsureYearLoadedAllCanceled(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 第一次请求一直阻塞到被中止
+		if requests.Add(1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(`{"holidays": {"2030-10-01": "国庆节"}, "workdays": {}, "inLieuDays": {}}`))
+	}))
+	defer server.Close()
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := checker.EnsureYearLoaded(ctx, 2030); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("EnsureYearLoaded error = %v, want context.DeadlineExceeded", err)
+	}
+
+	// 全部调用方取消后加载中止：不写入缓存，也不记录为加载失败
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		checker.loadMu.Lock()
+		inFlight := len(checker.loading)
+		checker.loadMu.Unlock()
+		if inFlight == 0 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if checker.IsYearLoaded(2030) {
+		t.Error("aborted load should not be cached")
+	}
+	if _, ok := checker.LoadReport(2030); ok {
+		t.Error("aborted load should not be recorded")
+	}
+	if n := checker.Counters().LoadFailures; n != 0 {
+		t.Errorf("LoadFailures = %d, want 0", n)
+	}
+
+	// 之后的调用方重新发起加载
+	if err := checker.EnsureYearLoaded(context.Background(), 2030); err != nil {
+		t.Fatalf("EnsureYearLoaded failed: %v", err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("requests = %d, want 2", n)
+	}
+}
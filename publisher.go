@@ -0,0 +1,83 @@
+package cnholiday
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// DefaultEventSubject 变更事件默认发布的主题
+const DefaultEventSubject = "cnholiday.changes"
+
+// MessagePublisher 消息总线的最小发布接口
+// Kafka、NATS 等客户端只需简单适配即可使用，例如 NATS 的 nc.Publish(subject, payload)
+type MessagePublisher interface {
+	Publish(ctx context.Context, subject string, payload []byte) error
+}
+
+// PublisherFunc 将普通函数适配为 MessagePublisher
+type PublisherFunc func(ctx context.Context, subject string, payload []byte) error
+
+// Publish 实现 MessagePublisher
+func (f PublisherFunc) Publish(ctx context.Context, subject string, payload []byte) error {
+	return f(ctx, subject, payload)
+}
+
+// EventPublisher 将数据变更事件以 JSON 发布到消息总线
+// Handle 只将事件放入有界队列，由单独的 goroutine 按接收顺序逐个发布，不阻塞触发变更的加载、修订或轮询；
+// 不再使用时调用 Close 等待队列中的事件发布完
+//
+//	pub := &cnholiday.EventPublisher{Publisher: bus}
+//	cancel := checker.Subscribe(pub.Handle)
+//	defer pub.Close()
+type EventPublisher struct {
+	Publisher MessagePublisher
+	Subject   string        // 发布主题，默认 DefaultEventSubject
+	Timeout   time.Duration // 单次发布超时，0 表示不限制
+	QueueSize int           // Handle 的队列长度，默认 DefaultEventQueueSize
+	// OnError 发布失败时在发布 goroutine 中调用；队列已满或已关闭时以 ErrEventQueueFull、ErrEventQueueClosed
+	// 在 Handle 的调用方 goroutine 中调用，事件被丢弃
+	OnError func(event ChangeEvent, err error)
+
+	queue eventQueue
+}
+
+// Handle 将变更事件放入发布队列后立即返回，可直接传给 Checker.Subscribe
+// 事件按 Handle 的调用顺序发布，同一年份的事件即按数据版本的顺序发布
+func (p *EventPublisher) Handle(event ChangeEvent) {
+	if err := p.queue.push(p.QueueSize, event, p.publish); err != nil && p.OnError != nil {
+		p.OnError(event, err)
+	}
+}
+
+// Close 停止接收新的事件，并等待队列中已有的事件发布完，可以多次调用
+func (p *EventPublisher) Close() {
+	p.queue.close()
+}
+
+// publish 发布队列中的一个事件
+func (p *EventPublisher) publish(event ChangeEvent) {
+	if err := p.Publish(context.Background(), event); err != nil && p.OnError != nil {
+		p.OnError(event, err)
+	}
+}
+
+// Publish 将变更事件编码为 JSON 后同步发布
+func (p *EventPublisher) Publish(ctx context.Context, event ChangeEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if p.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.Timeout)
+		defer cancel()
+	}
+
+	subject := p.Subject
+	if subject == "" {
+		subject = DefaultEventSubject
+	}
+	return p.Publisher.Publish(ctx, subject, payload)
+}
@@ -0,0 +1,108 @@
+package cnholiday
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestEventPublisher(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	var subject string
+	var payload []byte
+	pub := &EventPublisher{Publisher: PublisherFunc(func(ctx context.Context, s string, p []byte) error {
+		subject, payload = s, p
+		return nil
+	})}
+	defer checker.Subscribe(pub.Handle)()
+
+	if _, err := checker.PatchYear(2026, &HolidayData{Workdays: map[string]string{"2026-10-10": ""}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	pub.Close()
+	if subject != DefaultEventSubject {
+		t.Errorf("subject = %q, want %q", subject, DefaultEventSubject)
+	}
+
+	var event struct {
+		Year    int    `json:"year"`
+		Version int    `json:"version"`
+		Source  string `json:"source"`
+		Changes []struct {
+			Before string `json:"before"`
+			After  string `json:"after"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal(payload, &event); err != nil {
+		t.Fatalf("invalid payload %s: %v", payload, err)
+	}
+	if event.Year != 2026 || event.Version != 2 || event.Source != "patch" || len(event.Changes) != 1 {
+		t.Fatalf("event = %+v", event)
+	}
+	if event.Changes[0].Before != "adjusted_workday" || event.Changes[0].After != "weekend" {
+		t.Errorf("change = %+v", event.Changes[0])
+	}
+}
+
+func TestEventPublisherError(t *testing.T) {
+	publishErr := errors.New("broker unavailable")
+	var got error
+	pub := &EventPublisher{
+		Publisher: PublisherFunc(func(context.Context, string, []byte) error { return publishErr }),
+		Subject:   "holidays",
+		OnError:   func(_ ChangeEvent, err error) { got = err },
+	}
+	pub.Handle(ChangeEvent{Year: 2026})
+	pub.Close()
+	if !errors.Is(got, publishErr) {
+		t.Errorf("OnError got %v, want %v", got, publishErr)
+	}
+
+	pub.Handle(ChangeEvent{Year: 2026})
+	if !errors.Is(got, ErrEventQueueClosed) {
+		t.Errorf("OnError after Close got %v, want %v", got, ErrEventQueueClosed)
+	}
+}
+
+func TestEventPublisherQueue(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var published []int
+	var dropped []int
+	pub := &EventPublisher{
+		Publisher: PublisherFunc(func(_ context.Context, _ string, payload []byte) error {
+			var event ChangeEvent
+			json.Unmarshal(payload, &event)
+			if event.Version == 1 {
+				close(started)
+				<-release
+			}
+			published = append(published, event.Version)
+			return nil
+		}),
+		QueueSize: 2,
+		OnError:   func(event ChangeEvent, err error) { dropped = append(dropped, event.Version) },
+	}
+
+	// 发布阻塞时 Handle 不阻塞，超出队列长度的事件被丢弃
+	pub.Handle(ChangeEvent{Version: 1})
+	<-started
+	for v := 2; v <= 4; v++ {
+		pub.Handle(ChangeEvent{Version: v})
+	}
+	close(release)
+	pub.Close()
+
+	if !slices.Equal(published, []int{1, 2, 3}) {
+		t.Errorf("published = %v, want [1 2 3]", published)
+	}
+	if !slices.Equal(dropped, []int{4}) {
+		t.Errorf("dropped = %v, want [4]", dropped)
+	}
+}
@@ -0,0 +1,102 @@
+package cnholiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// Period 一段报告期(季度或财年)的工作日情况
+type Period struct {
+	Start        time.Time // 第一天
+	End          time.Time // 最后一天(包含)
+	Workdays     int       // 工作日天数(含调休工作日)
+	FirstWorkday time.Time // 第一个工作日
+	LastWorkday  time.Time // 最后一个工作日
+}
+
+// Quarter 返回自然年 year 第 q 季度(1-4)的工作日情况
+func (c *Checker) Quarter(year, q int) (*Period, error) {
+	if q < 1 || q > 4 {
+		return nil, fmt.Errorf("季度必须在 1 到 4 之间: %d", q)
+	}
+	start := time.Date(year, time.Month(3*(q-1)+1), 1, 0, 0, 0, 0, time.Local)
+	return c.period(start, start.AddDate(0, 3, -1))
+}
+
+// WorkdaysInQuarter 返回自然年 year 第 q 季度的工作日天数
+func (c *Checker) WorkdaysInQuarter(year, q int) (int, error) {
+	p, err := c.Quarter(year, q)
+	if err != nil {
+		return 0, err
+	}
+	return p.Workdays, nil
+}
+
+// FirstWorkdayOfQuarter 返回自然年 year 第 q 季度的第一个工作日
+func (c *Checker) FirstWorkdayOfQuarter(year, q int) (time.Time, error) {
+	p, err := c.Quarter(year, q)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return p.FirstWorkday, nil
+}
+
+// LastWorkdayOfQuarter 返回自然年 year 第 q 季度的最后一个工作日
+func (c *Checker) LastWorkdayOfQuarter(year, q int) (time.Time, error) {
+	p, err := c.Quarter(year, q)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return p.LastWorkday, nil
+}
+
+// FiscalYearOf 返回日期所属的财年及财季
+// 财年以起始月份所在的自然年命名，例如起始月份为 4 月时，2027 年 3 月属于 2026 财年第 4 季度
+func (c *Checker) FiscalYearOf(date time.Time) (fiscalYear, quarter int) {
+	months := int(date.Month()) - int(c.settings().FiscalYearStart)
+	fiscalYear = date.Year()
+	if months < 0 {
+		months += 12
+		fiscalYear--
+	}
+	return fiscalYear, months/3 + 1
+}
+
+// FiscalYear 返回财年 fiscalYear 的工作日情况，起始月份由 Config.FiscalYearStart 决定
+func (c *Checker) FiscalYear(fiscalYear int) (*Period, error) {
+	start := c.fiscalStart(fiscalYear)
+	return c.period(start, start.AddDate(1, 0, -1))
+}
+
+// FiscalQuarter 返回财年 fiscalYear 第 q 财季(1-4)的工作日情况
+func (c *Checker) FiscalQuarter(fiscalYear, q int) (*Period, error) {
+	if q < 1 || q > 4 {
+		return nil, fmt.Errorf("季度必须在 1 到 4 之间: %d", q)
+	}
+	start := c.fiscalStart(fiscalYear).AddDate(0, 3*(q-1), 0)
+	return c.period(start, start.AddDate(0, 3, -1))
+}
+
+func (c *Checker) fiscalStart(fiscalYear int) time.Time {
+	return time.Date(fiscalYear, c.settings().FiscalYearStart, 1, 0, 0, 0, 0, time.Local)
+}
+
+// period 统计 [start, end] 闭区间的工作日情况
+func (c *Checker) period(start, end time.Time) (*Period, error) {
+	p := &Period{Start: start, End: end}
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		isWorkday, err := c.IsWorkday(date)
+		if err != nil {
+			return nil, err
+		}
+		if !isWorkday {
+			continue
+		}
+		if p.Workdays == 0 {
+			p.FirstWorkday = date
+		}
+		p.LastWorkday = date
+		p.Workdays++
+	}
+	return p, nil
+}
@@ -0,0 +1,77 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuarter(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	p, err := checker.Quarter(2026, 4)
+	if err != nil {
+		t.Fatalf("Quarter failed: %v", err)
+	}
+	if got := p.FirstWorkday.Format("2006-01-02"); got != "2026-10-08" {
+		t.Errorf("FirstWorkday = %s, want 2026-10-08", got)
+	}
+	if got := p.LastWorkday.Format("2006-01-02"); got != "2026-12-31" {
+		t.Errorf("LastWorkday = %s, want 2026-12-31", got)
+	}
+
+	count, err := checker.CountWorkdaysBetween(p.Start, p.End)
+	if err != nil {
+		t.Fatalf("CountWorkdaysBetween failed: %v", err)
+	}
+	workdays, err := checker.WorkdaysInQuarter(2026, 4)
+	if err != nil {
+		t.Fatalf("WorkdaysInQuarter failed: %v", err)
+	}
+	if workdays != count {
+		t.Errorf("WorkdaysInQuarter() = %d, want %d", workdays, count)
+	}
+
+	if _, err := checker.Quarter(2026, 5); err == nil {
+		t.Error("expected error for quarter 5")
+	}
+}
+
+func TestFiscalYear(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true, FiscalYearStart: time.April})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	tests := []struct {
+		date    time.Time
+		year    int
+		quarter int
+	}{
+		{time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), 2025, 4},
+		{time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), 2026, 1},
+		{time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC), 2026, 3},
+	}
+	for _, tt := range tests {
+		year, quarter := checker.FiscalYearOf(tt.date)
+		if year != tt.year || quarter != tt.quarter {
+			t.Errorf("FiscalYearOf(%s) = %d Q%d, want %d Q%d",
+				tt.date.Format("2006-01-02"), year, quarter, tt.year, tt.quarter)
+		}
+	}
+
+	p, err := checker.FiscalQuarter(2026, 3)
+	if err != nil {
+		t.Fatalf("FiscalQuarter failed: %v", err)
+	}
+	if p.Start.Format("2006-01-02") != "2026-10-01" || p.End.Format("2006-01-02") != "2026-12-31" {
+		t.Errorf("FiscalQuarter(2026, 3) = %s..%s, want 2026-10-01..2026-12-31",
+			p.Start.Format("2006-01-02"), p.End.Format("2006-01-02"))
+	}
+
+	if _, err := checker.FiscalYear(2026); err == nil {
+		t.Error("expected error when fiscal year spans a year without data")
+	}
+}
@@ -0,0 +1,286 @@
+package cnholiday
+
+import (
+	"errors"
+	"time"
+)
+
+// CountWorkdaysBetween 统计 [start, end] 闭区间内的工作日天数(含调休工作日)，其他边界使用 CountWorkdaysIn
+func (c *Checker) CountWorkdaysBetween(start, end time.Time) (int, error) {
+	return c.CountWorkdaysIn(DateRange{Start: start, End: end})
+}
+
+// CountWorkdaysIn 统计区间内的工作日天数(含调休工作日)
+func (c *Checker) CountWorkdaysIn(r DateRange) (int, error) {
+	return countWorkdays(c.IsWorkday, r)
+}
+
+// AreAllWorkdays 判断 dates 是否全部是工作日，否则返回第一个非工作日
+func (c *Checker) AreAllWorkdays(dates []time.Time) (bool, time.Time, error) {
+	for _, date := range dates {
+		isWorkday, err := c.IsWorkday(date)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		if !isWorkday {
+			return false, date, nil
+		}
+	}
+	return true, time.Time{}, nil
+}
+
+// RangeContainsHoliday 判断 [start, end] 闭区间内是否包含节假日(休息日，与 IsHoliday 一致)，
+// 包含时返回第一个节假日；其他边界使用 RangeContainsHolidayIn
+func (c *Checker) RangeContainsHoliday(start, end time.Time) (bool, time.Time, error) {
+	return c.RangeContainsHolidayIn(DateRange{Start: start, End: end})
+}
+
+// RangeContainsHolidayIn 判断区间内是否包含节假日(休息日，与 IsHoliday 一致)，包含时返回第一个节假日
+func (c *Checker) RangeContainsHolidayIn(r DateRange) (bool, time.Time, error) {
+	if err := r.Validate(); err != nil {
+		return false, time.Time{}, err
+	}
+
+	for date := range r.All() {
+		isHoliday, _, err := c.IsHoliday(date)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		if isHoliday {
+			return true, date, nil
+		}
+	}
+	return false, time.Time{}, nil
+}
+
+// NextWorkday 返回 date 之后(不含当天)的第一个工作日
+func (c *Checker) NextWorkday(date time.Time) (time.Time, error) {
+	if day, ok := c.jumpWorkdays(date, 1); ok {
+		return day, nil
+	}
+	return c.seekWorkday(date, 1)
+}
+
+// PrevWorkday 返回 date 之前(不含当天)的最后一个工作日
+func (c *Checker) PrevWorkday(date time.Time) (time.Time, error) {
+	if day, ok := c.jumpWorkdays(date, -1); ok {
+		return day, nil
+	}
+	return c.seekWorkday(date, -1)
+}
+
+// AddWorkdays 返回 date 之后第 n 个工作日(不含当天)，n 为负数时向前查找，n 为 0 时返回 date
+func (c *Checker) AddWorkdays(date time.Time, n int) (time.Time, error) {
+	if day, ok := c.jumpWorkdays(date, n); ok {
+		return day, nil
+	}
+	return StepWorkdays(c.IsWorkday, date, n)
+}
+
+// WorkdaysBefore 返回截止日期 deadline 之前第 n 个工作日(不含截止日当天)
+// 用于"至少提前 n 个工作日提交"一类的规则，例如截止日为周一、n 为 1 时返回上周五
+func (c *Checker) WorkdaysBefore(deadline time.Time, n int) (time.Time, error) {
+	if n < 0 {
+		return time.Time{}, errors.New("工作日天数不能为负数")
+	}
+	return c.AddWorkdays(deadline, -n)
+}
+
+// NextHoliday 返回 date 之后(不含当天)的第一个放假日(法定节假日或补休日，不含普通周末)，
+// 本年没有时继续查找以后的年份，直到某一年份的数据加载失败
+func (c *Checker) NextHoliday(date time.Time) (*HolidayInfo, error) {
+	return c.seekHoliday(date, 1)
+}
+
+// PrevHoliday 返回 date 之前(不含当天)的最后一个放假日，参见 NextHoliday
+func (c *Checker) PrevHoliday(date time.Time) (*HolidayInfo, error) {
+	return c.seekHoliday(date, -1)
+}
+
+func (c *Checker) seekHoliday(date time.Time, step int) (*HolidayInfo, error) {
+	day, idx, err := c.seek(date, step, (*yearIndex).offDays, false)
+	if err != nil {
+		return nil, err
+	}
+	info := &HolidayInfo{}
+	dayType, name := idx.lookup(day)
+	fillHolidayInfo(info, day, dayType, name)
+	info.Source = idx.source
+	info.DataVersion = idx.version
+	return info, nil
+}
+
+// seekWorkday 沿 step 方向查找第一个工作日
+func (c *Checker) seekWorkday(date time.Time, step int) (time.Time, error) {
+	day, _, err := c.seek(date, step, (*yearIndex).workdays, true)
+	return day, err
+}
+
+// seek 沿 step 方向查找 date 之后(不含当天)第一个属于 pick 所选序号表的日期，在每个年份的序号表中二分查找，
+// 不逐日查询；返回值保留 date 的时刻和时区。年份数据加载失败时，heuristic 为 true 且开启了 HeuristicFallback
+// 则在该年份逐日按周末推断工作日，否则返回错误
+func (c *Checker) seek(date time.Time, step int, pick func(*yearIndex) []uint16, heuristic bool) (time.Time, *yearIndex, error) {
+	first := date.AddDate(0, 0, step)
+	year, from := first.Year(), first.YearDay()-1
+	for {
+		jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, date.Location())
+		idx, err := c.index(year)
+		if err != nil {
+			if !heuristic || !c.settings().HeuristicFallback {
+				return time.Time{}, nil, err
+			}
+			for day := jan1.AddDate(0, 0, from); day.Year() == year; day = day.AddDate(0, 0, step) {
+				if dayType, _, _, _ := c.classify(day); dayType.IsWorkday() {
+					return date.AddDate(0, 0, daysBetween(date, day)), nil, nil
+				}
+			}
+		} else if ord, ok := search(pick(idx), from, step); ok {
+			day := jan1.AddDate(0, 0, ord)
+			c.countQuery(idx.types[ord])
+			return date.AddDate(0, 0, daysBetween(date, day)), idx, nil
+		}
+
+		year += step
+		from = 0
+		if step < 0 {
+			from = time.Date(year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay() - 1
+		}
+	}
+}
+
+// ListHolidays 返回指定年份放假期间(含补休日)的全部日期，按日期升序排列，不包含普通周末
+// 与其他只接受年份的列表方法一样，日期为 time.Local 中的零点；需要其他时区时使用 ListHolidaysIn
+func (c *Checker) ListHolidays(year int) ([]HolidayInfo, error) {
+	return c.ListHolidaysIn(YearRange(year))
+}
+
+// ListHolidaysIn 返回区间内放假期间(含补休日)的全部日期，按日期升序排列，不包含普通周末
+// 日期为 r.Start 时区中的零点
+func (c *Checker) ListHolidaysIn(r DateRange) ([]HolidayInfo, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	var (
+		idx      *yearIndex
+		holidays []HolidayInfo
+	)
+	for day := range r.All() {
+		if idx == nil || idx.year != day.Year() {
+			var err error
+			if idx, err = c.index(day.Year()); err != nil {
+				return nil, err
+			}
+		}
+		dayType, name := idx.lookup(day)
+		if dayType == DayTypePublicHoliday || dayType == DayTypeInLieu {
+			var info HolidayInfo
+			fillHolidayInfo(&info, day, dayType, name)
+			holidays = append(holidays, info)
+		}
+	}
+	return holidays, nil
+}
+
+// InLieuDays 返回指定年份的补休日，按日期升序排列，HolidayName 为对应的节日，日期为 time.Local 中的零点
+// 补休日是因调休改为放假的周一至周五，与 AdjustedWorkdays 返回的调休工作日共同构成一次调休安排
+func (c *Checker) InLieuDays(year int) ([]HolidayInfo, error) {
+	idx, err := c.index(year)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []HolidayInfo
+	day := time.Date(year, 1, 1, 0, 0, 0, 0, time.Local)
+	for day.Year() == year {
+		dayType, name := idx.lookup(day)
+		if dayType == DayTypeInLieu {
+			var info HolidayInfo
+			fillHolidayInfo(&info, day, dayType, name)
+			days = append(days, info)
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return days, nil
+}
+
+// AdjustedWorkdays 返回指定年份因调休需要上班的周末日期，按日期升序排列，
+// HolidayName 为对应的节日，日期为 time.Local 中的零点，供薪资核对等需要逐年审核调休安排的场景使用。
+// 包含相邻年份数据中落在本年的调休工作日，例如次年元旦调休到本年 12 月的周末
+func (c *Checker) AdjustedWorkdays(year int) ([]HolidayInfo, error) {
+	idx, err := c.index(year)
+	if err != nil {
+		return nil, err
+	}
+
+	var workdays []HolidayInfo
+	day := time.Date(year, 1, 1, 0, 0, 0, 0, time.Local)
+	for day.Year() == year {
+		dayType, name := idx.lookup(day)
+		if dayType == DayTypeAdjustedWorkday {
+			var info HolidayInfo
+			fillHolidayInfo(&info, day, dayType, name)
+			workdays = append(workdays, info)
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return workdays, nil
+}
+
+// YearInfo 返回指定年份每一天的节假日信息(365 或 366 条)，按日期升序排列，日期为 time.Local 中的零点
+// 直接读取年份索引批量生成，结果与逐日调用 GetHolidayInfo 一致，适合每日生成日期维度表的 ETL 任务
+func (c *Checker) YearInfo(year int) ([]HolidayInfo, error) {
+	idx, err := c.index(year)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]HolidayInfo, 0, 366)
+	day := time.Date(year, 1, 1, 0, 0, 0, 0, time.Local)
+	for day.Year() == year {
+		dayType, name := idx.lookup(day)
+		days = append(days, HolidayInfo{})
+		info := &days[len(days)-1]
+		fillHolidayInfo(info, day, dayType, name)
+		info.Source = idx.source
+		info.DataVersion = idx.version
+		day = day.AddDate(0, 0, 1)
+	}
+	return days, nil
+}
+
+// WorkdayFraction 返回子区间工作日数占整个周期工作日数的比例，用于按工作日折算工资或订阅费用
+// 两个区间均为闭区间，其他边界使用 WorkdayFractionIn
+func (c *Checker) WorkdayFraction(periodStart, periodEnd, subStart, subEnd time.Time) (float64, error) {
+	return c.WorkdayFractionIn(DateRange{Start: periodStart, End: periodEnd}, DateRange{Start: subStart, End: subEnd})
+}
+
+// WorkdayFractionIn 返回子区间 sub 工作日数占整个周期 period 工作日数的比例，
+// 子区间超出周期的部分会被截去；周期内没有工作日时返回错误
+func (c *Checker) WorkdayFractionIn(period, sub DateRange) (float64, error) {
+	total, err := c.CountWorkdaysIn(period)
+	if err != nil {
+		return 0, err
+	}
+	if total == 0 {
+		return 0, errors.New("周期内没有工作日")
+	}
+
+	// 按日期截取
+	period, sub = period.Closed(), sub.Closed()
+	if sub.Start.Before(period.Start) {
+		sub.Start = period.Start
+	}
+	if sub.End.After(period.End) {
+		sub.End = period.End
+	}
+	if sub.End.Before(sub.Start) {
+		return 0, nil
+	}
+
+	part, err := c.CountWorkdaysIn(sub)
+	if err != nil {
+		return 0, err
+	}
+	return float64(part) / float64(total), nil
+}
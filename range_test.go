@@ -0,0 +1,399 @@
+package cnholiday
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCountWorkdaysBetween(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+	count, err := checker.CountWorkdaysBetween(start, end)
+	if err != nil {
+		t.Fatalf("CountWorkdaysBetween failed: %v", err)
+	}
+	if count != 18 {
+		t.Errorf("CountWorkdaysBetween() = %d, want 18", count)
+	}
+
+	if _, err := checker.CountWorkdaysBetween(end, start); err == nil {
+		t.Error("expected error when end is before start")
+	}
+}
+
+func TestWorkdayFraction(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	periodStart, periodEnd := day("2026-10-01"), day("2026-10-31")
+
+	tests := []struct {
+		name             string
+		subStart, subEnd string
+		expected         float64
+	}{
+		// 10 月 18 个工作日，12 日入职后有 15 个
+		{"月中入职", "2026-10-12", "2026-10-31", 15.0 / 18},
+		// 国庆假期内没有工作日
+		{"假期内", "2026-10-01", "2026-10-07", 0},
+		{"超出周期截断", "2026-09-01", "2026-12-31", 1},
+		{"不相交", "2026-11-01", "2026-11-30", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.WorkdayFraction(periodStart, periodEnd, day(tt.subStart), day(tt.subEnd))
+			if err != nil {
+				t.Fatalf("WorkdayFraction failed: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("WorkdayFraction() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := checker.WorkdayFraction(day("2026-10-01"), day("2026-10-07"), day("2026-10-01"), day("2026-10-07")); err == nil {
+		t.Error("expected error for period without workdays")
+	}
+
+	// 10 月 12 日入职、30 日离职，离职日不计：12 ~ 29 日有 14 个工作日
+	period := DateRange{Start: periodStart, End: periodEnd}
+	sub := DateRange{Start: day("2026-10-12"), End: day("2026-10-30"), Bounds: BoundsClosedOpen}
+	if got, err := checker.WorkdayFractionIn(period, sub); err != nil || got != 14.0/18 {
+		t.Errorf("WorkdayFractionIn(%s, %s) = %v, %v; want %v", period, sub, got, err, 14.0/18)
+	}
+}
+
+func TestNextPrevWorkday(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	next, err := checker.NextWorkday(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("NextWorkday failed: %v", err)
+	}
+	if got := next.Format("2006-01-02"); got != "2026-10-08" {
+		t.Errorf("NextWorkday() = %s, want 2026-10-08", got)
+	}
+
+	prev, err := checker.PrevWorkday(time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("PrevWorkday failed: %v", err)
+	}
+	if got := prev.Format("2006-01-02"); got != "2026-09-30" {
+		t.Errorf("PrevWorkday() = %s, want 2026-09-30", got)
+	}
+}
+
+func TestNextPrevSearchMatchesDayByDay(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	// probe 逐日查找，作为二分查找结果的对照
+	probe := func(date time.Time, step int, match func(DayType) bool) string {
+		for day := date.AddDate(0, 0, step); ; day = day.AddDate(0, 0, step) {
+			dayType, _, err := checker.Classify(day)
+			if err != nil {
+				return "error"
+			}
+			if match(dayType) {
+				return day.Format("2006-01-02 15:04")
+			}
+		}
+	}
+	isOff := func(t DayType) bool { return t == DayTypePublicHoliday || t == DayTypeInLieu }
+	format := func(date time.Time, err error) string {
+		if err != nil {
+			return "error"
+		}
+		return date.Format("2006-01-02 15:04")
+	}
+	formatInfo := func(info *HolidayInfo, err error) string {
+		if err != nil {
+			return "error"
+		}
+		return info.Date.Format("2006-01-02 15:04")
+	}
+
+	loc := time.FixedZone("UTC+8", 8*3600)
+	for date := time.Date(2024, 1, 1, 9, 30, 0, 0, loc); date.Year() <= 2026; date = date.AddDate(0, 0, 1) {
+		key := date.Format("2006-01-02")
+		if got, want := format(checker.NextWorkday(date)), probe(date, 1, DayType.IsWorkday); got != want {
+			t.Errorf("NextWorkday(%s) = %s, want %s", key, got, want)
+		}
+		if got, want := format(checker.PrevWorkday(date)), probe(date, -1, DayType.IsWorkday); got != want {
+			t.Errorf("PrevWorkday(%s) = %s, want %s", key, got, want)
+		}
+		if got, want := formatInfo(checker.NextHoliday(date)), probe(date, 1, isOff); got != want {
+			t.Errorf("NextHoliday(%s) = %s, want %s", key, got, want)
+		}
+		if got, want := formatInfo(checker.PrevHoliday(date)), probe(date, -1, isOff); got != want {
+			t.Errorf("PrevHoliday(%s) = %s, want %s", key, got, want)
+		}
+	}
+}
+
+func TestNextPrevHoliday(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	// 跨年查找
+	next, err := checker.NextHoliday(time.Date(2025, 10, 9, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("NextHoliday failed: %v", err)
+	}
+	if got := next.Date.Format("2006-01-02"); got != "2026-01-01" || next.HolidayName == "" || next.Source != SourceEmbedded {
+		t.Errorf("NextHoliday() = %s %+v, want 2026-01-01", got, next)
+	}
+	prev, err := checker.PrevHoliday(time.Date(2026, 10, 10, 0, 0, 0, 0, time.Local))
+	if err != nil {
+		t.Fatalf("PrevHoliday failed: %v", err)
+	}
+	if got := prev.Date.Format("2006-01-02"); got != "2026-10-07" || !prev.IsInLieuDay {
+		t.Errorf("PrevHoliday() = %s %+v, want in-lieu day 2026-10-07", got, prev)
+	}
+
+	// 后续年份没有数据时返回错误
+	var loadErr *YearLoadError
+	if _, err := checker.NextHoliday(time.Date(2026, 12, 31, 0, 0, 0, 0, time.Local)); !errors.As(err, &loadErr) || loadErr.Year != 2027 {
+		t.Errorf("NextHoliday(2026-12-31) error = %v, want *YearLoadError for 2027", err)
+	}
+
+	// 开启 HeuristicFallback 时，缺少数据的年份按周末推断工作日，但不推断放假日
+	heuristic := NewCheckerWithConfig(Config{DisableRemote: true, HeuristicFallback: true})
+	workday, err := heuristic.NextWorkday(time.Date(2026, 12, 31, 0, 0, 0, 0, time.Local))
+	if err != nil || workday.Format("2006-01-02") != "2027-01-01" {
+		t.Errorf("NextWorkday(2026-12-31) = %s, %v, want heuristic 2027-01-01", workday.Format("2006-01-02"), err)
+	}
+	workday, err = heuristic.PrevWorkday(time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local))
+	if err != nil || workday.Format("2006-01-02") != "2023-12-29" {
+		t.Errorf("PrevWorkday(2024-01-01) = %s, %v, want heuristic 2023-12-29", workday.Format("2006-01-02"), err)
+	}
+	if _, err := heuristic.NextHoliday(time.Date(2026, 12, 31, 0, 0, 0, 0, time.Local)); err == nil {
+		t.Error("NextHoliday should not infer holidays for years without data")
+	}
+}
+
+func TestListHolidays(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	holidays, err := checker.ListHolidays(2026)
+	if err != nil {
+		t.Fatalf("ListHolidays failed: %v", err)
+	}
+	if len(holidays) == 0 {
+		t.Fatal("ListHolidays() returned no holidays")
+	}
+	for i, h := range holidays {
+		if !h.IsHoliday {
+			t.Errorf("%s is not a holiday", h.Date)
+		}
+		if i > 0 && !holidays[i-1].Date.Before(h.Date) {
+			t.Errorf("holidays not sorted: %s before %s", holidays[i-1].Date, h.Date)
+		}
+	}
+
+	if _, err := checker.ListHolidays(1990); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
+
+func TestInLieuDays(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	days, err := checker.InLieuDays(2026)
+	if err != nil {
+		t.Fatalf("InLieuDays failed: %v", err)
+	}
+
+	want := []string{"2026-01-02", "2026-02-20", "2026-02-23", "2026-05-05", "2026-10-06", "2026-10-07"}
+	var got []string
+	for _, d := range days {
+		got = append(got, d.Date.Format("2006-01-02"))
+		if !d.IsInLieuDay || !d.IsHoliday {
+			t.Errorf("%s is not an in-lieu holiday", d.Date.Format("2006-01-02"))
+		}
+		if d.Weekday == time.Saturday || d.Weekday == time.Sunday {
+			t.Errorf("%s is a %s, want weekday", d.Date.Format("2006-01-02"), d.Weekday)
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("InLieuDays(2026) = %v, want %v", got, want)
+	}
+
+	if _, err := checker.InLieuDays(1990); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
+
+func TestAdjustedWorkdays(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	workdays, err := checker.AdjustedWorkdays(2026)
+	if err != nil {
+		t.Fatalf("AdjustedWorkdays failed: %v", err)
+	}
+
+	want := []string{"2026-01-04", "2026-02-14", "2026-02-28", "2026-05-09", "2026-09-20", "2026-10-10"}
+	var got []string
+	for _, w := range workdays {
+		got = append(got, w.Date.Format("2006-01-02"))
+		if !w.IsAdjustedWorkday || !w.IsWorkday {
+			t.Errorf("%s is not an adjusted workday", w.Date.Format("2006-01-02"))
+		}
+		if w.Weekday != time.Saturday && w.Weekday != time.Sunday {
+			t.Errorf("%s is a %s, want weekend", w.Date.Format("2006-01-02"), w.Weekday)
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("AdjustedWorkdays(2026) = %v, want %v", got, want)
+	}
+	if name := chineseName(workdays[len(workdays)-1].HolidayName); name != "国庆节" {
+		t.Errorf("2026-10-10 holiday = %q, want 国庆节", name)
+	}
+
+	if _, err := checker.AdjustedWorkdays(1990); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
+
+func TestYearInfo(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	for year, want := range map[int]int{2024: 366, 2026: 365} {
+		days, err := checker.YearInfo(year)
+		if err != nil {
+			t.Fatalf("YearInfo(%d) failed: %v", year, err)
+		}
+		if len(days) != want {
+			t.Errorf("len(YearInfo(%d)) = %d, want %d", year, len(days), want)
+		}
+	}
+
+	days, _ := checker.YearInfo(2026)
+	for _, day := range days {
+		info, err := checker.GetHolidayInfo(day.Date)
+		if err != nil {
+			t.Fatalf("GetHolidayInfo failed: %v", err)
+		}
+		if *info != day {
+			t.Fatalf("YearInfo %s = %+v, GetHolidayInfo = %+v", day.Date.Format("2006-01-02"), day, *info)
+		}
+	}
+
+	if _, err := checker.YearInfo(2099); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
+
+func TestAddWorkdays(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	start := time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "2026-09-30"},
+		{1, "2026-10-08"},
+		{3, "2026-10-10"},
+		{-1, "2026-09-29"},
+	}
+	for _, tt := range tests {
+		got, err := checker.AddWorkdays(start, tt.n)
+		if err != nil {
+			t.Fatalf("AddWorkdays(%d) failed: %v", tt.n, err)
+		}
+		if got.Format("2006-01-02") != tt.want {
+			t.Errorf("AddWorkdays(%d) = %s, want %s", tt.n, got.Format("2006-01-02"), tt.want)
+		}
+	}
+}
+
+func TestWorkdaysBefore(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	deadline := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "2026-10-12"},
+		{1, "2026-10-10"},
+		{3, "2026-10-08"},
+		{4, "2026-09-30"},
+	}
+	for _, tt := range tests {
+		got, err := checker.WorkdaysBefore(deadline, tt.n)
+		if err != nil {
+			t.Fatalf("WorkdaysBefore(%d) failed: %v", tt.n, err)
+		}
+		if got.Format("2006-01-02") != tt.want {
+			t.Errorf("WorkdaysBefore(%d) = %s, want %s", tt.n, got.Format("2006-01-02"), tt.want)
+		}
+	}
+
+	if _, err := checker.WorkdaysBefore(deadline, -1); err == nil {
+		t.Error("expected error for negative n")
+	}
+}
+
+func TestAreAllWorkdays(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	ok, first, err := checker.AreAllWorkdays([]time.Time{day("2026-10-09"), day("2026-10-10"), day("2026-10-12")})
+	if err != nil || !ok || !first.IsZero() {
+		t.Errorf("AreAllWorkdays() = %v, %v, %v; want true", ok, first, err)
+	}
+
+	ok, first, err = checker.AreAllWorkdays([]time.Time{day("2026-09-30"), day("2026-10-02"), day("2026-10-03")})
+	if err != nil || ok || first.Format("2006-01-02") != "2026-10-02" {
+		t.Errorf("AreAllWorkdays() = %v, %s, %v; want false, 2026-10-02", ok, first.Format("2006-01-02"), err)
+	}
+}
+
+func TestRangeContainsHoliday(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	found, first, err := checker.RangeContainsHoliday(day("2026-10-08"), day("2026-10-10"))
+	if err != nil || found {
+		t.Errorf("RangeContainsHoliday() = %v, %s, %v; want false", found, first.Format("2006-01-02"), err)
+	}
+
+	found, first, err = checker.RangeContainsHoliday(day("2026-09-28"), day("2026-10-05"))
+	if err != nil || !found || first.Format("2006-01-02") != "2026-10-01" {
+		t.Errorf("RangeContainsHoliday() = %v, %s, %v; want true, 2026-10-01", found, first.Format("2006-01-02"), err)
+	}
+
+	if _, _, err := checker.RangeContainsHoliday(day("2026-10-05"), day("2026-10-01")); err == nil {
+		t.Error("expected error when end is before start")
+	}
+
+	// 不包含结束日期 10 月 1 日
+	r := DateRange{Start: day("2026-09-28"), End: day("2026-10-01"), Bounds: BoundsClosedOpen}
+	if found, first, err := checker.RangeContainsHolidayIn(r); err != nil || found {
+		t.Errorf("RangeContainsHolidayIn(%s) = %v, %s, %v; want false", r, found, first.Format("2006-01-02"), err)
+	}
+}
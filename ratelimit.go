@@ -0,0 +1,120 @@
+package cnholiday
+
+import (
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited 远程请求超过限流速率，本次请求被跳过
+var ErrRateLimited = errors.New("远程请求过于频繁，已被限流")
+
+// tokenBucket 令牌桶限流器
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // 每秒补充的令牌数
+	burst  float64 // 桶容量
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newTokenBucket 创建令牌桶，初始为满桶
+func newTokenBucket(rate float64, burst int, now func() time.Time) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now(),
+		now:    now,
+	}
+}
+
+// allow 尝试取出一个令牌，不阻塞
+func (b *tokenBucket) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// refund 退回 allow 取出的令牌，用于其他维度拒绝了同一个请求的情况
+func (b *tokenBucket) refund() {
+	b.mu.Lock()
+	b.tokens = min(b.tokens+1, b.burst)
+	b.mu.Unlock()
+}
+
+// rateLimiter 远程请求限流器，同时支持全局限流和按主机限流
+type rateLimiter struct {
+	mu       sync.Mutex
+	global   *tokenBucket
+	hostRate float64
+	burst    int
+	hosts    map[string]*tokenBucket
+	now      func() time.Time
+}
+
+// newRateLimiter 创建限流器，速率 <= 0 表示对应维度不限流
+func newRateLimiter(globalRate, hostRate float64, burst int) *rateLimiter {
+	l := &rateLimiter{
+		hostRate: hostRate,
+		burst:    burst,
+		hosts:    make(map[string]*tokenBucket),
+		now:      time.Now,
+	}
+	if globalRate > 0 {
+		l.global = newTokenBucket(globalRate, burst, l.now)
+	}
+	return l
+}
+
+// allow 判断是否允许向 rawURL 发起请求，两个维度都允许时才消耗令牌
+func (l *rateLimiter) allow(rawURL string) bool {
+	var bucket *tokenBucket
+	if l.hostRate > 0 {
+		host := rawURL
+		if u, err := url.Parse(rawURL); err == nil {
+			host = u.Host
+		}
+
+		l.mu.Lock()
+		var ok bool
+		bucket, ok = l.hosts[host]
+		if !ok {
+			bucket = newTokenBucket(l.hostRate, l.burst, l.now)
+			l.hosts[host] = bucket
+		}
+		l.mu.Unlock()
+
+		if !bucket.allow() {
+			return false
+		}
+	}
+
+	if l.global != nil && !l.global.allow() {
+		// 全局限流拒绝的请求没有发出，退回主机维度的令牌
+		if bucket != nil {
+			bucket.refund()
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+package cnholiday
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	b := newTokenBucket(1, 2, clock)
+
+	if !b.allow() || !b.allow() {
+		t.Fatal("burst requests should be allowed")
+	}
+	if b.allow() {
+		t.Fatal("request beyond burst should be rejected")
+	}
+
+	now = now.Add(time.Second)
+	if !b.allow() {
+		t.Fatal("token should be refilled after one second")
+	}
+	if b.allow() {
+		t.Fatal("only one token should be refilled")
+	}
+}
+
+func TestRateLimiterPerHost(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(0, 1, 1)
+	l.now = func() time.Time { return now }
+
+	if !l.allow("https://a.example.com/2026.json") {
+		t.Fatal("first request to host a should be allowed")
+	}
+	if l.allow("https://a.example.com/2025.json") {
+		t.Fatal("second request to host a should be limited")
+	}
+	if !l.allow("https://b.example.com/2026.json") {
+		t.Fatal("hosts should be limited independently")
+	}
+}
+
+func TestRateLimiterGlobal(t *testing.T) {
+	l := newRateLimiter(1, 0, 1)
+	if !l.allow("https://a.example.com/2026.json") {
+		t.Fatal("first request should be allowed")
+	}
+	if l.allow("https://b.example.com/2026.json") {
+		t.Fatal("global limit should apply across hosts")
+	}
+}
+
+func TestRateLimiterRefundsHostToken(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(1, 0.1, 1)
+	l.now = func() time.Time { return now }
+	l.global.now = l.now
+
+	if !l.allow("https://a.example.com/2026.json") {
+		t.Fatal("first request should be allowed")
+	}
+	if l.allow("https://b.example.com/2026.json") {
+		t.Fatal("global limit should apply across hosts")
+	}
+
+	// 全局令牌恢复后，host b 的令牌没有被上一次被拒绝的请求消耗
+	now = now.Add(time.Second)
+	if !l.allow("https://b.example.com/2026.json") {
+		t.Fatal("host token should be refunded when the global limit rejects")
+	}
+}
+
+func TestRemoteRateLimit(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(emptyYearJSON))
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:      server.URL,
+		RemoteRateLimit: 0.001,
+	})
+
+	if _, err := checker.loadYearFromRemote(context.Background(), 2026); err != nil {
+		t.Fatalf("first remote load failed: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := checker.loadYearFromRemote(context.Background(), 2026); !errors.Is(err, ErrRateLimited) {
+			t.Fatalf("expected ErrRateLimited, got %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("remote requests = %d, want 1", got)
+	}
+}
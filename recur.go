@@ -0,0 +1,253 @@
+package cnholiday
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Frequency 重复规则的周期
+type Frequency uint8
+
+const (
+	FreqDaily   Frequency = iota // 每天
+	FreqWeekly                   // 每周
+	FreqMonthly                  // 每月
+	FreqYearly                   // 每年
+)
+
+// maxRollDays 顺延规则最多移动的天数，用于确定需要计算的名义日期范围
+const maxRollDays = 31
+
+// Rule 重复规则，例如"每月 15 日，遇非工作日顺延"
+type Rule struct {
+	Freq     Frequency
+	Interval int       // 间隔周期数，默认 1
+	Anchor   time.Time // 第一次发生的名义日期，每周规则的星期、每年规则的月份取自该日期
+	// MonthDay 每月/每年规则的日期，负数表示倒数第几天，0 表示取 Anchor 的日期
+	// 超过当月天数时取当月最后一天
+	MonthDay int
+	// NthWorkday 每月规则按当月第几个工作日发生，负数表示倒数第几个工作日
+	// 不为 0 时忽略 MonthDay 与 Roll
+	NthWorkday int
+	// Weekdays 每周规则在一周(周一至周日)内发生的星期，为空时取 Anchor 的星期；Anchor 所在周中早于 Anchor 的日期不发生
+	Weekdays []time.Weekday
+	// NthWeekday 每月规则按当月第几个 Weekday 发生，负数表示倒数第几个，例如 -1 与 time.Friday 为每月最后一个周五；
+	// 不为 0 时忽略 MonthDay。取值为 ±5 时没有第 5 个该星期的月份不发生，也不计入 Count
+	NthWeekday int
+	Weekday    time.Weekday
+	Roll       RollConvention
+	Count      int       // 最多发生次数(按名义日期计)，0 表示不限制
+	Until      time.Time // 名义日期的上限(包含)，零值表示不限制
+}
+
+// Occurrences 返回规则在 [start, end] 闭区间内的所有发生日期(已按顺延规则调整)，按日期升序排列
+// 其他边界使用 OccurrencesIn
+func (c *Checker) Occurrences(rule Rule, start, end time.Time) ([]time.Time, error) {
+	return c.OccurrencesIn(rule, DateRange{Start: start, End: end})
+}
+
+// OccurrencesIn 返回规则在区间内的所有发生日期(已按顺延规则调整)，按日期升序排列
+func (c *Checker) OccurrencesIn(rule Rule, r DateRange) ([]time.Time, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if r.empty() {
+		return nil, nil
+	}
+	// 按日期比较，结束日期当天的任何时刻都在区间内
+	start, end := r.first(), r.last().AddDate(0, 0, 1).Add(-time.Nanosecond)
+	if rule.Interval < 0 {
+		return nil, fmt.Errorf("间隔周期数不能为负数: %d", rule.Interval)
+	}
+	if rule.Interval == 0 {
+		rule.Interval = 1
+	}
+	if rule.NthWorkday != 0 && rule.Freq != FreqMonthly {
+		return nil, errors.New("NthWorkday 仅适用于每月规则")
+	}
+	if len(rule.Weekdays) > 0 && rule.Freq != FreqWeekly {
+		return nil, errors.New("Weekdays 仅适用于每周规则")
+	}
+	if rule.NthWeekday != 0 {
+		switch {
+		case rule.Freq != FreqMonthly:
+			return nil, errors.New("NthWeekday 仅适用于每月规则")
+		case rule.NthWorkday != 0:
+			return nil, errors.New("NthWeekday 与 NthWorkday 不能同时使用")
+		case rule.NthWeekday < -5 || rule.NthWeekday > 5:
+			return nil, fmt.Errorf("NthWeekday 超出范围: %d", rule.NthWeekday)
+		}
+	}
+
+	var dates []time.Time
+	lower, upper := rule.window(start, end)
+	if !rule.Until.IsZero() && rule.Until.Before(upper) {
+		upper = rule.Until
+	}
+	for k, count := 0, 0; rule.Count == 0 || count < rule.Count; k++ {
+		nominal, exists, err := rule.nominal(k)
+		if err != nil {
+			return nil, err
+		}
+		if nominal.After(upper) {
+			break
+		}
+		if !exists {
+			continue
+		}
+		count++
+		if nominal.Before(lower) {
+			continue
+		}
+
+		var date time.Time
+		ok := true
+		if rule.NthWorkday != 0 {
+			date, err = c.NthWorkdayOfMonth(nominal.Year(), nominal.Month(), rule.NthWorkday)
+		} else {
+			date, ok, err = c.Roll(nominal, rule.Roll)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !ok || date.Before(start) || date.After(end) {
+			continue
+		}
+		// 顺延后可能与上一次发生日期重合
+		if n := len(dates); n > 0 && !date.After(dates[n-1]) {
+			continue
+		}
+		dates = append(dates, date)
+	}
+	return dates, nil
+}
+
+// window 返回调整后可能落入 [start, end] 的名义日期范围，避免加载区间外年份的数据
+func (r Rule) window(start, end time.Time) (lower, upper time.Time) {
+	monthStart := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location())
+	monthEnd := time.Date(end.Year(), end.Month()+1, 1, 0, 0, 0, 0, end.Location()).Add(-time.Nanosecond)
+	if r.NthWorkday != 0 {
+		return monthStart, monthEnd
+	}
+	switch r.Roll {
+	case RollFollowing:
+		return start.AddDate(0, 0, -maxRollDays), end
+	case RollPreceding:
+		return start, end.AddDate(0, 0, maxRollDays)
+	case RollModifiedFollowing, RollModifiedPreceding:
+		// 调整后的日期不会跨月
+		return monthStart, monthEnd
+	default:
+		return start, end
+	}
+}
+
+// nominal 返回第 k 个周期的名义日期(未顺延)，随 k 递增
+// 该周期不发生时(当月没有第 5 个该星期)返回 false，日期为当月第一天，仅用于判断是否超出范围
+func (r Rule) nominal(k int) (time.Time, bool, error) {
+	a := r.Anchor
+	step := k * r.Interval
+	switch r.Freq {
+	case FreqDaily:
+		return a.AddDate(0, 0, step), true, nil
+	case FreqWeekly:
+		if len(r.Weekdays) > 0 {
+			return r.weekday(k), true, nil
+		}
+		return a.AddDate(0, 0, 7*step), true, nil
+	case FreqMonthly:
+		if r.NthWeekday != 0 {
+			date, ok := r.nthWeekday(a.Year(), a.Month()+time.Month(step), a)
+			return date, ok, nil
+		}
+		return r.monthDay(a.Year(), a.Month()+time.Month(step), a), true, nil
+	case FreqYearly:
+		return r.monthDay(a.Year()+step, a.Month(), a), true, nil
+	default:
+		return time.Time{}, false, fmt.Errorf("未知的重复周期: %d", r.Freq)
+	}
+}
+
+// weekday 返回按 Weekdays 发生的每周规则第 k 次发生的日期
+// 星期按距周一的天数排列，Anchor 所在周只包含 Anchor 当天及之后的日期
+func (r Rule) weekday(k int) time.Time {
+	var offsets [7]int // 一周内发生的日期距周一的天数，升序
+	n := 0
+	for offset := range 7 {
+		if slices.Contains(r.Weekdays, time.Weekday((offset+1)%7)) {
+			offsets[n] = offset
+			n++
+		}
+	}
+
+	a := r.Anchor
+	anchorOffset := (int(a.Weekday()) + 6) % 7
+	monday := a.AddDate(0, 0, -anchorOffset)
+	first := 0 // Anchor 所在周中第一个发生日期的下标
+	for first < n && offsets[first] < anchorOffset {
+		first++
+	}
+	if k < n-first {
+		return monday.AddDate(0, 0, offsets[first+k])
+	}
+	k -= n - first
+	week := (k/n + 1) * r.Interval
+	return monday.AddDate(0, 0, 7*week+offsets[k%n])
+}
+
+// nthWeekday 返回 year 年 month 月(可以超出 1-12)的第 NthWeekday 个 Weekday，该月没有时返回 false
+func (r Rule) nthWeekday(year int, month time.Month, a time.Time) (time.Time, bool) {
+	first := time.Date(year, month, 1, a.Hour(), a.Minute(), a.Second(), a.Nanosecond(), a.Location())
+	var date time.Time
+	if r.NthWeekday > 0 {
+		date = first.AddDate(0, 0, (int(r.Weekday)-int(first.Weekday())+7)%7+(r.NthWeekday-1)*7)
+	} else {
+		last := first.AddDate(0, 1, -1)
+		date = last.AddDate(0, 0, -(int(last.Weekday())-int(r.Weekday)+7)%7+(r.NthWeekday+1)*7)
+	}
+	if date.Month() != first.Month() {
+		return first, false
+	}
+	return date, true
+}
+
+// monthDay 返回 year 年 month 月中 MonthDay 对应的日期，month 可以超出 1-12
+func (r Rule) monthDay(year int, month time.Month, a time.Time) time.Time {
+	first := time.Date(year, month, 1, a.Hour(), a.Minute(), a.Second(), a.Nanosecond(), a.Location())
+	days := first.AddDate(0, 1, -1).Day()
+
+	day := r.MonthDay
+	if day == 0 {
+		day = a.Day()
+	}
+	if day < 0 {
+		day = days + day + 1
+	}
+	day = min(max(day, 1), days)
+	return first.AddDate(0, 0, day-1)
+}
+
+// NthWorkdayOfMonth 返回 year 年 month 月的第 n 个工作日，n 为负数时表示倒数第几个工作日
+func (c *Checker) NthWorkdayOfMonth(year int, month time.Month, n int) (time.Time, error) {
+	if n == 0 {
+		return time.Time{}, errors.New("n 不能为 0")
+	}
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	date, step, count := first.AddDate(0, 0, -1), 1, n
+	if n < 0 {
+		date, step, count = first.AddDate(0, 1, 0), -1, -n
+	}
+	for ; count > 0; count-- {
+		next, err := c.seekWorkday(date, step)
+		if err != nil {
+			return time.Time{}, err
+		}
+		date = next
+	}
+	if date.Month() != first.Month() {
+		return time.Time{}, fmt.Errorf("%d 年 %d 月没有第 %d 个工作日", year, month, n)
+	}
+	return date, nil
+}
@@ -0,0 +1,102 @@
+package cnholiday
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func formatDates(dates []time.Time) []string {
+	out := make([]string, len(dates))
+	for i, d := range dates {
+		out[i] = d.Format("2006-01-02")
+	}
+	return out
+}
+
+func TestOccurrences(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		rule Rule
+		from time.Time
+		want []string
+	}{
+		{
+			name: "monthly 1st, following",
+			rule: Rule{Freq: FreqMonthly, Anchor: start, Roll: RollFollowing},
+			from: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			want: []string{"2026-09-01", "2026-10-08", "2026-11-02", "2026-12-01"},
+		},
+		{
+			name: "monthly last day, skip",
+			rule: Rule{Freq: FreqMonthly, Anchor: start, MonthDay: -1, Roll: RollSkip},
+			from: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+			want: []string{"2026-09-30", "2026-11-30", "2026-12-31"},
+		},
+		{
+			name: "second to last workday",
+			rule: Rule{Freq: FreqMonthly, Anchor: start, NthWorkday: -2},
+			from: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			want: []string{"2026-10-29", "2026-11-27", "2026-12-30"},
+		},
+		{
+			name: "daily, following collapses holidays",
+			rule: Rule{Freq: FreqDaily, Anchor: start, Roll: RollFollowing},
+			from: time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC),
+			want: []string{"2026-12-24", "2026-12-25", "2026-12-28", "2026-12-29", "2026-12-30", "2026-12-31"},
+		},
+	}
+	for _, tt := range tests {
+		got, err := checker.Occurrences(tt.rule, tt.from, end)
+		if err != nil {
+			t.Fatalf("%s: Occurrences failed: %v", tt.name, err)
+		}
+		if g := formatDates(got); !slices.Equal(g, tt.want) {
+			t.Errorf("%s: Occurrences() = %v, want %v", tt.name, g, tt.want)
+		}
+	}
+
+	if _, err := checker.Occurrences(Rule{Freq: FreqWeekly, Anchor: start, NthWorkday: 1}, start, end); err == nil {
+		t.Error("expected error for NthWorkday on weekly rule")
+	}
+	if _, err := checker.Occurrences(Rule{Freq: FreqMonthly, Anchor: start, Weekdays: []time.Weekday{time.Monday}}, start, end); err == nil {
+		t.Error("expected error for Weekdays on monthly rule")
+	}
+	if _, err := checker.Occurrences(Rule{Freq: FreqMonthly, Anchor: start, NthWeekday: 1, NthWorkday: 1}, start, end); err == nil {
+		t.Error("expected error for NthWeekday with NthWorkday")
+	}
+
+	// 不包含结束日期 12 月 1 日
+	rule := Rule{Freq: FreqMonthly, Anchor: start, Roll: RollFollowing}
+	r := DateRange{Start: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC), Bounds: BoundsClosedOpen}
+	got, err := checker.OccurrencesIn(rule, r)
+	if err != nil || !slices.Equal(formatDates(got), []string{"2026-10-08", "2026-11-02"}) {
+		t.Errorf("OccurrencesIn(%s) = %v, %v", r, formatDates(got), err)
+	}
+}
+
+func TestNthWorkdayOfMonth(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	got, err := checker.NthWorkdayOfMonth(2026, time.October, 1)
+	if err != nil {
+		t.Fatalf("NthWorkdayOfMonth failed: %v", err)
+	}
+	if got.Format("2006-01-02") != "2026-10-08" {
+		t.Errorf("NthWorkdayOfMonth(2026, 10, 1) = %s, want 2026-10-08", got.Format("2006-01-02"))
+	}
+
+	if _, err := checker.NthWorkdayOfMonth(2026, time.October, 30); err == nil {
+		t.Error("expected error when month has fewer workdays")
+	}
+}
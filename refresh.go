@@ -0,0 +1,53 @@
+package cnholiday
+
+import (
+	"slices"
+	"time"
+)
+
+// asyncSources 开启 AsyncRefresh 时调整数据源顺序：远程移到最后，
+// 返回值 refresh 表示由其他数据源作答后需要在后台从远程刷新
+func (c *Checker) asyncSources(sources []Source) (ordered []Source, refresh bool) {
+	if !c.settings().AsyncRefresh || len(sources) < 2 || !slices.Contains(sources, SourceRemote) {
+		return sources, false
+	}
+	ordered = slices.DeleteFunc(slices.Clone(sources), func(s Source) bool { return s == SourceRemote })
+	return append(ordered, SourceRemote), true
+}
+
+// refreshAsync 在后台从远程加载年份数据，成功时替换缓存，失败时保留缓存中的数据并记录到 LoadReport
+// 同一年份同时只有一个后台刷新，Close 之后不再发起
+func (c *Checker) refreshAsync(year int) {
+	c.mu.Lock()
+	if c.closed || c.refreshing[year] {
+		c.mu.Unlock()
+		return
+	}
+	if c.refreshing == nil {
+		c.refreshing = make(map[int]bool)
+	}
+	c.refreshing[year] = true
+	c.background.Add(1) // 持有 mu 时登记，保证 Close 等待时不会再有新的后台任务
+	c.mu.Unlock()
+
+	go func() {
+		defer c.background.Done()
+		defer func() {
+			c.mu.Lock()
+			delete(c.refreshing, year)
+			c.mu.Unlock()
+		}()
+
+		start := time.Now()
+		data, err := c.loadYearFromRemote(c.lifetime, year)
+		attempts := []LoadAttempt{{Source: SourceRemote, Start: start, Duration: time.Since(start), Err: err}}
+		if c.lifetime.Err() != nil {
+			return // 被 Close 中止，不记录
+		}
+		if err != nil {
+			c.recordFailure(year, attempts, &YearLoadError{Year: year, Errors: []*SourceError{{Source: SourceRemote, Err: err}}})
+			return
+		}
+		c.store(year, data, SourceRemote, attempts)
+	}()
+}
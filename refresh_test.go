@@ -0,0 +1,112 @@
+package cnholiday
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAsyncRefresh(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		if r.URL.Path != "/2026.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"holidays": {"2026-10-12": "公司假期"}, "workdays": {}, "inLieuDays": {}}`))
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL, AsyncRefresh: true})
+	changes := make(chan ChangeEvent, 1)
+	checker.Subscribe(func(e ChangeEvent) { changes <- e })
+
+	// 远程请求被阻塞时，首次查询直接使用嵌入数据作答
+	date := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+	isHoliday, _, err := checker.IsHoliday(date)
+	if err != nil || isHoliday {
+		t.Fatalf("IsHoliday = %v, %v", isHoliday, err)
+	}
+	if report, _ := checker.LoadReport(2026); report.Source != SourceEmbedded {
+		t.Errorf("source = %s, want embedded", report.Source)
+	}
+
+	close(release)
+	checker.background.Wait()
+	if report, _ := checker.LoadReport(2026); report.Source != SourceRemote {
+		t.Errorf("source = %s, want remote after refresh", report.Source)
+	}
+	if isHoliday, name, _ := checker.IsHoliday(date); !isHoliday || name != "公司假期" {
+		t.Errorf("IsHoliday after refresh = %v, %q", isHoliday, name)
+	}
+	select {
+	case e := <-changes:
+		if e.Year != 2026 || e.Source != SourceRemote {
+			t.Errorf("change event = %+v", e)
+		}
+	default:
+		t.Error("subscribers should be notified after refresh")
+	}
+
+	// 后台刷新失败时保留已有数据
+	if err := checker.LoadYear(2025); err != nil {
+		t.Fatalf("LoadYear(2025) failed: %v", err)
+	}
+	checker.background.Wait()
+	report, _ := checker.LoadReport(2025)
+	if report.Source != SourceEmbedded || report.Err == nil {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestClose(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-r.Context().Done() // 一直挂起，直到请求被取消
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL, AsyncRefresh: true})
+	checker.Subscribe(func(ChangeEvent) {})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+
+	// 与查询并发关闭，Close 取消挂起的后台刷新后返回
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			checker.IsWorkday(time.Date(2026, 10, 9, 0, 0, 0, 0, time.UTC))
+		}()
+		go func() {
+			defer wg.Done()
+			checker.Close()
+		}()
+	}
+	wg.Wait()
+
+	report, _ := checker.LoadReport(2026)
+	if report.Source != SourceEmbedded || report.Err != nil {
+		t.Errorf("report = %+v, cancelled refresh should not be recorded", report)
+	}
+	if len(checker.subscribers) != 0 {
+		t.Error("Close should remove subscribers")
+	}
+
+	// 关闭后查询仍然可用，但不再发起后台刷新
+	before := requests.Load()
+	if isWorkday, err := checker.IsWorkday(time.Date(2025, 10, 9, 0, 0, 0, 0, time.UTC)); err != nil || !isWorkday {
+		t.Errorf("IsWorkday after Close = %v, %v", isWorkday, err)
+	}
+	checker.background.Wait()
+	if requests.Load() != before {
+		t.Error("no background refresh should start after Close")
+	}
+}
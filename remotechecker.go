@@ -0,0 +1,77 @@
+package cnholiday
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// RemoteChecker 客户端模式的检查器，从部署的 cnholiday HTTP 服务(见 NewHandler)获取数据，
+// 不使用本地文件和内置数据。每个年份只请求一次并缓存在本地，查询在本地完成
+type RemoteChecker struct {
+	checker *Checker
+}
+
+// NewRemoteChecker 创建指向 serviceURL 的客户端检查器，例如 "https://holiday.example.com"
+// config 中的超时、熔断、限流、代理与 TLS 等远程请求配置依然生效，数据源相关配置会被忽略
+func NewRemoteChecker(serviceURL string, config Config) *RemoteChecker {
+	config.CDNBaseURL = strings.TrimRight(serviceURL, "/") + "/v1/years"
+	config.RemoteURLTemplate = ""
+	config.DisableRemote = false
+	config.LocalDataDir = ""
+	config.ConfigCenter = nil
+
+	checker := NewCheckerWithConfig(config)
+	checker.remoteOnly = true
+	return &RemoteChecker{checker: checker}
+}
+
+// Close 停止客户端的后台任务，见 Checker.Close
+func (r *RemoteChecker) Close() error {
+	return r.checker.Close()
+}
+
+// LoadYear 从服务加载指定年份的数据
+func (r *RemoteChecker) LoadYear(year int) error {
+	return r.checker.LoadYear(year)
+}
+
+// LoadYearContext 从服务加载指定年份的数据，ctx 取消时停止请求
+func (r *RemoteChecker) LoadYearContext(ctx context.Context, year int) error {
+	return r.checker.LoadYearContext(ctx, year)
+}
+
+// IsHoliday 判断指定日期是否是节假日(休息日)
+func (r *RemoteChecker) IsHoliday(date time.Time) (bool, string, error) {
+	return r.checker.IsHoliday(date)
+}
+
+// IsWorkday 判断指定日期是否是工作日
+func (r *RemoteChecker) IsWorkday(date time.Time) (bool, error) {
+	return r.checker.IsWorkday(date)
+}
+
+// GetHolidayInfo 获取节假日详细信息
+func (r *RemoteChecker) GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
+	return r.checker.GetHolidayInfo(date)
+}
+
+// Classify 返回指定日期的类型及对应的节日名称
+func (r *RemoteChecker) Classify(date time.Time) (DayType, string, error) {
+	return r.checker.Classify(date)
+}
+
+// CountWorkdaysBetween 统计 [start, end] 闭区间内的工作日天数
+func (r *RemoteChecker) CountWorkdaysBetween(start, end time.Time) (int, error) {
+	return r.checker.CountWorkdaysBetween(start, end)
+}
+
+// AddWorkdays 返回 date 之后第 n 个工作日(不含当天)，n 为负数时向前查找
+func (r *RemoteChecker) AddWorkdays(date time.Time, n int) (time.Time, error) {
+	return r.checker.AddWorkdays(date, n)
+}
+
+// ClearCache 清除本地缓存，下次查询时重新请求服务
+func (r *RemoteChecker) ClearCache() {
+	r.checker.ClearCache()
+}
@@ -0,0 +1,47 @@
+package cnholiday
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRemoteChecker(t *testing.T) {
+	var requests atomic.Int32
+	handler := NewHandler(NewCheckerWithConfig(Config{DisableRemote: true}))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client := NewRemoteChecker(server.URL+"/", Config{})
+	for _, day := range []int{1, 8, 10} {
+		if _, err := client.IsWorkday(time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC)); err != nil {
+			t.Fatalf("IsWorkday failed: %v", err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("service received %d requests, want 1 (year should be cached)", got)
+	}
+
+	info, err := client.GetHolidayInfo(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if !info.IsHoliday || info.Source != SourceRemote {
+		t.Errorf("info = %+v, want remote holiday", info)
+	}
+
+	// 服务没有数据时不回退到内置数据
+	if _, err := client.IsWorkday(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error when service has no data")
+	}
+	server.Close()
+	client.ClearCache()
+	if _, err := client.IsWorkday(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("expected error when service is down, embedded data must not be used")
+	}
+}
@@ -0,0 +1,165 @@
+package cnholiday
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ANSI 颜色
+const (
+	ansiRed   = "\x1b[31m"
+	ansiBlue  = "\x1b[34m"
+	ansiReset = "\x1b[0m"
+)
+
+// chineseWeekdays 以周一开头的中文星期表头
+var chineseWeekdays = [7]string{"一", "二", "三", "四", "五", "六", "日"}
+
+// RenderOptions 月历渲染选项
+type RenderOptions struct {
+	Color bool // 使用 ANSI 颜色：节假日红色，调休工作日蓝色
+}
+
+// RenderMonth 以终端月历的形式输出指定月份，周一为每周第一天
+// 放假日期标注"休"，调休工作日标注"班"，月历下方列出本月的节日及放假区间
+func (c *Checker) RenderMonth(w io.Writer, year int, month time.Month, opts RenderOptions) error {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	var days []HolidayInfo
+	for d := first; d.Month() == month; d = d.AddDate(0, 0, 1) {
+		info, err := c.GetHolidayInfo(d)
+		if err != nil {
+			return err
+		}
+		days = append(days, *info)
+	}
+
+	var b strings.Builder
+	title := fmt.Sprintf("%d年%d月", year, month)
+	// 标题居中：网格宽 35 列，中文字符占 2 列
+	fmt.Fprintf(&b, "%s%s\n", strings.Repeat(" ", (35-displayWidth(title))/2), title)
+	for _, wd := range chineseWeekdays {
+		fmt.Fprintf(&b, "  %s ", wd)
+	}
+	b.WriteString("\n")
+
+	offset := (int(first.Weekday()) + 6) % 7
+	b.WriteString(strings.Repeat("     ", offset))
+	for i, day := range days {
+		b.WriteString(renderCell(day, opts))
+		if (offset+i+1)%7 == 0 && i != len(days)-1 {
+			b.WriteString("\n")
+		}
+	}
+	b.WriteString("\n")
+
+	if spans := holidaySpans(days); len(spans) > 0 {
+		b.WriteString("\n")
+		for _, span := range spans {
+			b.WriteString(span + "\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// renderCell 渲染单个日期，固定占 5 列
+func renderCell(day HolidayInfo, opts RenderOptions) string {
+	mark, color := "  ", ""
+	switch day.DayType() {
+	case DayTypePublicHoliday, DayTypeInLieu:
+		mark, color = "休", ansiRed
+	case DayTypeAdjustedWorkday:
+		mark, color = "班", ansiBlue
+	case DayTypeWeekend:
+		color = ansiRed
+	}
+	cell := fmt.Sprintf(" %2d%s", day.Date.Day(), mark)
+	if opts.Color && color != "" {
+		return color + cell + ansiReset
+	}
+	return cell
+}
+
+// holidaySpans 汇总本月各节日的放假与调休日期
+func holidaySpans(days []HolidayInfo) []string {
+	type span struct {
+		name    string
+		off, on []int
+	}
+	var order []string
+	spans := make(map[string]*span)
+	for _, day := range days {
+		if day.HolidayName == "" {
+			continue
+		}
+		name := chineseName(day.HolidayName)
+		s, ok := spans[name]
+		if !ok {
+			s = &span{name: name}
+			spans[name] = s
+			order = append(order, name)
+		}
+		if day.IsAdjustedWorkday {
+			s.on = append(s.on, day.Date.Day())
+		} else {
+			s.off = append(s.off, day.Date.Day())
+		}
+	}
+
+	lines := make([]string, 0, len(order))
+	for _, name := range order {
+		s := spans[name]
+		line := name + ":"
+		if len(s.off) > 0 {
+			line += fmt.Sprintf(" %s 放假", formatDayList(s.off))
+		}
+		if len(s.on) > 0 {
+			line += fmt.Sprintf(" %s 上班", formatDayList(s.on))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// formatDayList 将日期合并为连续区间，例如 [1 2 3 5] -> "1-3日、5日"
+func formatDayList(days []int) string {
+	var parts []string
+	for i := 0; i < len(days); {
+		j := i
+		for j+1 < len(days) && days[j+1] == days[j]+1 {
+			j++
+		}
+		if i == j {
+			parts = append(parts, fmt.Sprintf("%d日", days[i]))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d日", days[i], days[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(parts, "、")
+}
+
+// chineseName 从 "English,中文,天数" 格式的名称中取出中文名称
+func chineseName(name string) string {
+	parts := strings.Split(name, ",")
+	if len(parts) >= 2 && parts[1] != "" {
+		return parts[1]
+	}
+	return name
+}
+
+// displayWidth 返回字符串在终端中占用的列数，非 ASCII 字符按 2 列计算
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		if r < 0x80 {
+			width++
+		} else {
+			width += 2
+		}
+	}
+	return width
+}
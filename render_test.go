@@ -0,0 +1,50 @@
+package cnholiday
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderMonth(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var b strings.Builder
+	if err := checker.RenderMonth(&b, 2026, time.October, RenderOptions{}); err != nil {
+		t.Fatalf("RenderMonth failed: %v", err)
+	}
+	out := b.String()
+
+	for _, want := range []string{
+		"2026年10月",
+		"  一   二   三   四   五   六   日 \n",
+		"                 1休  2休  3休  4休\n",
+		"  5休  6休  7休  8    9   10班 11  \n",
+		"国庆节: 1-7日 放假 10日 上班\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "\x1b[") {
+		t.Error("output should not contain ANSI codes without Color")
+	}
+
+	b.Reset()
+	if err := checker.RenderMonth(&b, 2026, time.October, RenderOptions{Color: true}); err != nil {
+		t.Fatalf("RenderMonth failed: %v", err)
+	}
+	if !strings.Contains(b.String(), ansiRed+"  1休"+ansiReset) {
+		t.Errorf("colored output missing red holiday:\n%q", b.String())
+	}
+
+	if err := checker.RenderMonth(&b, 1990, time.January, RenderOptions{}); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
+
+func TestFormatDayList(t *testing.T) {
+	if got := formatDayList([]int{1, 2, 3, 5, 7, 8}); got != "1-3日、5日、7-8日" {
+		t.Errorf("formatDayList() = %q", got)
+	}
+}
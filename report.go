@@ -0,0 +1,101 @@
+package cnholiday
+
+import (
+	"errors"
+	"time"
+)
+
+// LoadAttempt 一次数据源加载尝试
+type LoadAttempt struct {
+	Source   Source
+	Start    time.Time     // 开始尝试的时间
+	Duration time.Duration // 尝试耗时
+	Err      error         // 失败原因，成功时为 nil
+}
+
+// LoadReport 年份数据的加载报告
+// Source 与 LoadedAt 描述当前缓存中的数据；Attempts 与 Err 描述最近一次加载过程，
+// 最近一次加载失败时，若缓存中仍有旧数据，Source 与 LoadedAt 保持不变
+type LoadReport struct {
+	Year     int
+	Source   Source        // 当前缓存数据的来源，未加载成功时为空
+	LoadedAt time.Time     // 当前缓存数据的加载时间
+	Attempts []LoadAttempt // 最近一次加载按顺序尝试的数据源
+	Err      error         // 最近一次加载的错误，成功时为 nil
+
+	Anomalies []ValidationIssue // 当前缓存数据与历年规律不符之处，参见 DetectAnomalies
+}
+
+// Quarantined 返回最近一次加载中被隔离的数据，按尝试顺序排列
+func (r LoadReport) Quarantined() []*QuarantineError {
+	var result []*QuarantineError
+	for _, attempt := range r.Attempts {
+		var qerr *QuarantineError
+		if errors.As(attempt.Err, &qerr) {
+			result = append(result, qerr)
+		}
+	}
+	return result
+}
+
+// LoadReport 返回指定年份的加载报告
+// 年份从未加载过或已被清除时返回 false
+func (c *Checker) LoadReport(year int) (LoadReport, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	report, ok := c.reports[year]
+	if !ok {
+		return LoadReport{}, false
+	}
+	copied := *report
+	copied.Attempts = append([]LoadAttempt(nil), report.Attempts...)
+	copied.Anomalies = append([]ValidationIssue(nil), report.Anomalies...)
+	return copied, true
+}
+
+// store 写入年份数据并记录加载报告
+func (c *Checker) store(year int, data *HolidayData, source Source, attempts []LoadAttempt) Amendment {
+	stored := newYearData(data) // 索引保留数据用于跨年重建，复制以免调用方修改
+	now := time.Now()
+
+	c.mu.Lock()
+	spilled := c.recordCrossYear(year, stored, source, now)
+	idx := c.buildIndex(year, stored)
+	anomalies := detectAnomalies(idx)
+	c.cache[year] = idx
+	c.cacheGen.Add(1)
+	c.reports[year] = &LoadReport{
+		Year:      year,
+		Source:    source,
+		LoadedAt:  now,
+		Attempts:  attempts,
+		Anomalies: anomalies,
+	}
+	amendment := c.appendHistory(year, source, idx, now)
+	c.mu.Unlock()
+
+	c.countLoad(source)
+	if onAnomaly := c.settings().OnAnomaly; onAnomaly != nil && len(anomalies) > 0 {
+		onAnomaly(&Anomaly{Year: year, Source: source, Issues: anomalies})
+	}
+	for _, a := range spilled {
+		c.notifyChange(a.year, a.amendment)
+	}
+	c.notifyChange(year, amendment)
+	return amendment
+}
+
+// recordFailure 记录一次失败的加载，保留缓存中已有数据的来源信息
+func (c *Checker) recordFailure(year int, attempts []LoadAttempt, err error) {
+	c.counters.loadFailures.Add(1)
+	c.mu.Lock()
+	report := &LoadReport{Year: year, Attempts: attempts, Err: err}
+	if prev, ok := c.reports[year]; ok {
+		report.Source = prev.Source
+		report.LoadedAt = prev.LoadedAt
+		report.Anomalies = prev.Anomalies
+	}
+	c.reports[year] = report
+	c.mu.Unlock()
+}
@@ -0,0 +1,128 @@
+package cnholiday
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL})
+
+	if _, ok := checker.LoadReport(2026); ok {
+		t.Fatal("report should not exist before loading")
+	}
+
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+
+	report, ok := checker.LoadReport(2026)
+	if !ok {
+		t.Fatal("report should exist after loading")
+	}
+	if report.Source != SourceEmbedded {
+		t.Errorf("Source = %s, want %s", report.Source, SourceEmbedded)
+	}
+	if report.LoadedAt.IsZero() {
+		t.Error("LoadedAt should be set")
+	}
+	if report.Err != nil {
+		t.Errorf("Err = %v, want nil", report.Err)
+	}
+	if len(report.Attempts) != 2 {
+		t.Fatalf("len(Attempts) = %d, want 2", len(report.Attempts))
+	}
+	if report.Attempts[0].Source != SourceRemote || report.Attempts[0].Err == nil {
+		t.Errorf("first attempt should be a failed remote attempt: %+v", report.Attempts[0])
+	}
+	if report.Attempts[1].Source != SourceEmbedded || report.Attempts[1].Err != nil {
+		t.Errorf("second attempt should be a successful embedded attempt: %+v", report.Attempts[1])
+	}
+}
+
+func TestLoadReportFailureKeepsSource(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	if err := checker.LoadYearFromJSON(2099, []byte(emptyYearJSON)); err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+	loaded, _ := checker.LoadReport(2099)
+
+	// 内置数据不包含 2099 年，重新加载失败
+	if err := checker.LoadYear(2099); err == nil {
+		t.Fatal("expected LoadYear to fail")
+	}
+
+	report, ok := checker.LoadReport(2099)
+	if !ok {
+		t.Fatal("report should exist")
+	}
+	if report.Source != SourceJSON || !report.LoadedAt.Equal(loaded.LoadedAt) {
+		t.Errorf("failed reload should keep source info, got %s at %v", report.Source, report.LoadedAt)
+	}
+	var yearErr *YearLoadError
+	if !errors.As(report.Err, &yearErr) || yearErr.Year != 2099 {
+		t.Errorf("Err = %v, want *YearLoadError for 2099", report.Err)
+	}
+
+	checker.ClearYear(2099)
+	if _, ok := checker.LoadReport(2099); ok {
+		t.Error("report should be removed by ClearYear")
+	}
+}
+
+func TestQuarantine(t *testing.T) {
+	bad := []byte(`{"holidays": {"2026-13-01": "元旦"}, "workdays": {}, "inLieuDays": {}}`)
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "2026.json"), bad, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	checker := NewCheckerWithConfig(Config{DisableRemote: true, LocalDataDir: dir, StrictValidation: true})
+
+	// 没有缓存时隔离本地数据，回退到嵌入数据
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear failed: %v", err)
+	}
+	report, _ := checker.LoadReport(2026)
+	quarantined := report.Quarantined()
+	if report.Source != SourceEmbedded || len(quarantined) != 1 || quarantined[0].Source != SourceLocal || string(quarantined[0].Data) != string(bad) {
+		t.Fatalf("report = %+v, quarantined = %v", report, quarantined)
+	}
+
+	// 已有缓存时保留缓存中的数据，不回退
+	good, _ := embeddedData.ReadFile("data/2026.json")
+	if err := checker.LoadYearFromJSON(2026, good); err != nil {
+		t.Fatalf("LoadYearFromJSON failed: %v", err)
+	}
+	err := checker.LoadYear(2026)
+	var qerr *QuarantineError
+	if !errors.As(err, &qerr) {
+		t.Fatalf("LoadYear error = %v, want *QuarantineError", err)
+	}
+	report, _ = checker.LoadReport(2026)
+	if report.Source != SourceJSON || len(report.Attempts) != 1 || report.Err == nil {
+		t.Errorf("report = %+v", report)
+	}
+
+	// LoadYearFromJSON 拒绝的数据同样被隔离
+	err = checker.LoadYearFromJSON(2026, []byte(`{"holidays": `))
+	if !errors.As(err, &qerr) || qerr.Source != SourceJSON {
+		t.Fatalf("LoadYearFromJSON error = %v, want *QuarantineError", err)
+	}
+	if report, _ := checker.LoadReport(2026); report.Source != SourceJSON || len(report.Quarantined()) != 1 {
+		t.Errorf("report = %+v", report)
+	}
+	if isHoliday, name, _ := checker.IsHoliday(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)); !isHoliday || name == "" {
+		t.Error("previous good copy should still be served")
+	}
+}
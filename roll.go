@@ -0,0 +1,56 @@
+package cnholiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// RollConvention 日期落在非工作日时的顺延规则
+type RollConvention uint8
+
+const (
+	RollNone              RollConvention = iota // 不调整
+	RollFollowing                               // 顺延至下一个工作日
+	RollPreceding                               // 提前至上一个工作日
+	RollModifiedFollowing                       // 顺延至下一个工作日，跨月时改为提前
+	RollModifiedPreceding                       // 提前至上一个工作日，跨月时改为顺延
+	RollSkip                                    // 跳过该日期
+)
+
+// Roll 按 conv 调整日期，date 为工作日时原样返回
+// 第二个返回值为 false 表示该日期按 RollSkip 被跳过
+func (c *Checker) Roll(date time.Time, conv RollConvention) (time.Time, bool, error) {
+	if conv == RollNone {
+		return date, true, nil
+	}
+	isWorkday, err := c.IsWorkday(date)
+	if err != nil || isWorkday {
+		return date, err == nil, err
+	}
+
+	var rolled time.Time
+	switch conv {
+	case RollSkip:
+		return time.Time{}, false, nil
+	case RollFollowing:
+		rolled, err = c.NextWorkday(date)
+	case RollPreceding:
+		rolled, err = c.PrevWorkday(date)
+	case RollModifiedFollowing:
+		rolled, err = c.NextWorkday(date)
+		if err == nil && rolled.Month() != date.Month() {
+			rolled, err = c.PrevWorkday(date)
+		}
+	case RollModifiedPreceding:
+		rolled, err = c.PrevWorkday(date)
+		if err == nil && rolled.Month() != date.Month() {
+			rolled, err = c.NextWorkday(date)
+		}
+	default:
+		return time.Time{}, false, fmt.Errorf("未知的顺延规则: %d", conv)
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return rolled, true, nil
+}
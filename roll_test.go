@@ -0,0 +1,41 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoll(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	tests := []struct {
+		date string
+		conv RollConvention
+		want string
+		ok   bool
+	}{
+		{"2026-10-01", RollNone, "2026-10-01", true},
+		{"2026-10-01", RollFollowing, "2026-10-08", true},
+		{"2026-10-01", RollPreceding, "2026-09-30", true},
+		{"2026-10-01", RollModifiedPreceding, "2026-10-08", true},
+		{"2026-10-31", RollModifiedFollowing, "2026-10-30", true},
+		{"2026-10-01", RollSkip, "", false},
+		{"2026-10-09", RollSkip, "2026-10-09", true},
+	}
+	for _, tt := range tests {
+		got, ok, err := checker.Roll(day(tt.date), tt.conv)
+		if err != nil {
+			t.Fatalf("Roll(%s, %d) failed: %v", tt.date, tt.conv, err)
+		}
+		if ok != tt.ok || (ok && got.Format("2006-01-02") != tt.want) {
+			t.Errorf("Roll(%s, %d) = %s, %v; want %s, %v", tt.date, tt.conv, got.Format("2006-01-02"), ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package cnholiday
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rruleFreqs RFC 5545 FREQ 取值与重复周期的对应关系
+var rruleFreqs = map[string]Frequency{
+	"DAILY":   FreqDaily,
+	"WEEKLY":  FreqWeekly,
+	"MONTHLY": FreqMonthly,
+	"YEARLY":  FreqYearly,
+}
+
+// rruleWeekdays RFC 5545 BYDAY 的星期代码
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseRRule 解析 iCalendar(RFC 5545) 的 RRULE，dtstart 为第一次发生的日期
+// 支持 FREQ、INTERVAL、COUNT、UNTIL、单个 BYMONTHDAY 与 BYDAY：WEEKLY 规则的 BYDAY 为星期列表(BYDAY=MO,WE,FR)，
+// MONTHLY 规则的 BYDAY 为单个带序号的星期(BYDAY=-1FR 表示每月最后一个周五)。节假日按 roll 跳过(RollSkip)或顺延
+func ParseRRule(rrule string, dtstart time.Time, roll RollConvention) (Rule, error) {
+	rule := Rule{Anchor: dtstart, Roll: roll}
+	rrule = strings.TrimPrefix(strings.TrimSpace(rrule), "RRULE:")
+
+	hasFreq := false
+	var byDay []string
+	for _, part := range strings.Split(rrule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("RRULE 格式错误: %q", part)
+		}
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Freq, ok = rruleFreqs[strings.ToUpper(value)]
+			if !ok {
+				return Rule{}, fmt.Errorf("不支持的 FREQ: %s", value)
+			}
+			hasFreq = true
+		case "INTERVAL":
+			rule.Interval, err = parsePositive(value)
+		case "COUNT":
+			rule.Count, err = parsePositive(value)
+		case "BYMONTHDAY":
+			rule.MonthDay, err = strconv.Atoi(value)
+			if err == nil && (rule.MonthDay == 0 || rule.MonthDay < -31 || rule.MonthDay > 31) {
+				err = fmt.Errorf("BYMONTHDAY 超出范围: %s", value)
+			}
+		case "UNTIL":
+			rule.Until, err = parseRRuleTime(value, dtstart.Location())
+		case "BYDAY":
+			byDay = strings.Split(strings.ToUpper(value), ",")
+		default:
+			return Rule{}, fmt.Errorf("不支持的 RRULE 属性: %s", key)
+		}
+		if err != nil {
+			return Rule{}, fmt.Errorf("RRULE 属性 %s 无效: %w", key, err)
+		}
+	}
+	if !hasFreq {
+		return Rule{}, fmt.Errorf("RRULE 缺少 FREQ: %q", rrule)
+	}
+	if rule.MonthDay != 0 && rule.Freq != FreqMonthly && rule.Freq != FreqYearly {
+		return Rule{}, fmt.Errorf("BYMONTHDAY 仅适用于 MONTHLY 或 YEARLY 规则")
+	}
+	if byDay != nil {
+		if err := parseRRuleByDay(&rule, byDay); err != nil {
+			return Rule{}, fmt.Errorf("RRULE 属性 BYDAY 无效: %w", err)
+		}
+	}
+	return rule, nil
+}
+
+// parseRRuleByDay 按规则的周期解析 BYDAY 的各项，例如 "MO"、"-1FR"
+func parseRRuleByDay(rule *Rule, items []string) error {
+	switch rule.Freq {
+	case FreqWeekly:
+		for _, item := range items {
+			weekday, ok := rruleWeekdays[item]
+			if !ok {
+				return fmt.Errorf("WEEKLY 规则只支持不带序号的星期: %q", item)
+			}
+			rule.Weekdays = append(rule.Weekdays, weekday)
+		}
+	case FreqMonthly:
+		if len(items) != 1 || len(items[0]) < 3 {
+			return errors.New("MONTHLY 规则只支持单个带序号的星期，例如 -1FR")
+		}
+		item := items[0]
+		weekday, ok := rruleWeekdays[item[len(item)-2:]]
+		if !ok {
+			return fmt.Errorf("星期代码无效: %q", item)
+		}
+		n, err := strconv.Atoi(item[:len(item)-2])
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return fmt.Errorf("序号无效: %q", item)
+		}
+		if rule.MonthDay != 0 {
+			return errors.New("不能与 BYMONTHDAY 同时使用")
+		}
+		rule.NthWeekday, rule.Weekday = n, weekday
+	default:
+		return errors.New("仅适用于 WEEKLY 或 MONTHLY 规则")
+	}
+	return nil
+}
+
+func parsePositive(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, fmt.Errorf("必须为正整数: %s", value)
+	}
+	return n, nil
+}
+
+// parseRRuleTime 解析 UNTIL，支持日期(20261231)与 UTC 时间(20261231T235959Z)两种格式
+func parseRRuleTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// 日期形式的 UNTIL 包含当天
+	return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
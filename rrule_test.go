@@ -0,0 +1,56 @@
+package cnholiday
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	dtstart := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	start := dtstart
+	end := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		rrule string
+		roll  RollConvention
+		want  []string
+	}{
+		{"RRULE:FREQ=MONTHLY;BYMONTHDAY=1", RollFollowing, []string{"2026-09-01", "2026-10-08", "2026-11-02", "2026-12-01"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=1;COUNT=3", RollSkip, []string{"2026-09-01"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20261130", RollNone, []string{"2026-09-30", "2026-10-31", "2026-11-30"}},
+		{"FREQ=WEEKLY;INTERVAL=2;COUNT=3", RollPreceding, []string{"2026-09-01", "2026-09-15", "2026-09-29"}},
+		// dtstart 为周二，所在周的周一不发生
+		{"FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=6", RollNone, []string{"2026-09-02", "2026-09-04", "2026-09-07", "2026-09-09", "2026-09-11", "2026-09-14"}},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=TH,TU;UNTIL=20260920", RollNone, []string{"2026-09-01", "2026-09-03", "2026-09-15", "2026-09-17"}},
+		// 9 月最后一个周五是中秋节，顺延到 28 日
+		{"FREQ=MONTHLY;BYDAY=-1FR", RollFollowing, []string{"2026-09-28", "2026-10-30", "2026-11-27", "2026-12-25"}},
+		// 9 月、11 月没有第 5 个周四，不计入 COUNT
+		{"FREQ=MONTHLY;BYDAY=+5TH;COUNT=2", RollNone, []string{"2026-10-29", "2026-12-31"}},
+	}
+	for _, tt := range tests {
+		rule, err := ParseRRule(tt.rrule, dtstart, tt.roll)
+		if err != nil {
+			t.Fatalf("ParseRRule(%q) failed: %v", tt.rrule, err)
+		}
+		got, err := checker.Occurrences(rule, start, end)
+		if err != nil {
+			t.Fatalf("Occurrences(%q) failed: %v", tt.rrule, err)
+		}
+		if g := formatDates(got); !slices.Equal(g, tt.want) {
+			t.Errorf("Occurrences(%q) = %v, want %v", tt.rrule, g, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"", "INTERVAL=2", "FREQ=HOURLY", "FREQ=DAILY;BYDAY=MO", "FREQ=DAILY;COUNT=0", "FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=WEEKLY;BYDAY=1MO", "FREQ=WEEKLY;BYDAY=XX", "FREQ=MONTHLY;BYDAY=FR", "FREQ=MONTHLY;BYDAY=6FR",
+		"FREQ=MONTHLY;BYDAY=1MO,3MO", "FREQ=MONTHLY;BYDAY=1MO;BYMONTHDAY=1"} {
+		if _, err := ParseRRule(bad, dtstart, RollNone); err == nil {
+			t.Errorf("ParseRRule(%q) should fail", bad)
+		}
+	}
+}
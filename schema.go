@@ -0,0 +1,204 @@
+package cnholiday
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed schema/holiday-data.schema.json
+var schemaJSON []byte
+
+// Schema 返回年份数据文件的 JSON Schema
+func Schema() []byte {
+	return append([]byte(nil), schemaJSON...)
+}
+
+// ValidationIssue 数据校验发现的单个问题
+type ValidationIssue struct {
+	Path    string // 出错位置，例如 holidays.2026-13-01
+	Message string
+}
+
+func (i ValidationIssue) String() string {
+	if i.Path == "" {
+		return i.Message
+	}
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+// ValidationError 数据校验失败，包含全部问题
+type ValidationError struct {
+	Issues []ValidationIssue
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		msgs[i] = issue.String()
+	}
+	return fmt.Sprintf("数据校验失败: %s", strings.Join(msgs, "; "))
+}
+
+// CurrentSchemaVersion 本库读写的数据格式版本，对应数据文件中的 schemaVersion 字段
+// 数据格式发生不兼容的变化(例如条目改为带类型的对象、增加元数据)时递增
+const CurrentSchemaVersion = 1
+
+// 数据文件中的字段
+var (
+	schemaRequiredFields = []string{"holidays", "workdays"}
+	schemaFields         = map[string]bool{"schemaVersion": true, "holidays": true, "workdays": true, "inLieuDays": true}
+)
+
+// SchemaVersionError 数据文件的格式版本高于本库支持的版本，需要升级 cnholiday 才能读取
+type SchemaVersionError struct {
+	Version   int
+	Supported int
+}
+
+func (e *SchemaVersionError) Error() string {
+	return fmt.Sprintf("数据格式版本 %d 高于本库支持的版本 %d，请升级 cnholiday", e.Version, e.Supported)
+}
+
+// schemaVersion 返回数据文件的格式版本，只读取 schemaVersion 字段，其余内容的格式可能随版本变化
+// 缺少该字段的数据(本字段出现之前的文件)视为版本 1；版本高于 CurrentSchemaVersion 时返回 *SchemaVersionError
+func schemaVersion(jsonData []byte) (int, error) {
+	var header struct {
+		SchemaVersion *json.Number `json:"schemaVersion"`
+	}
+	if err := json.Unmarshal(jsonData, &header); err != nil {
+		return 0, fmt.Errorf("解析 JSON 失败: %w", err)
+	}
+	if header.SchemaVersion == nil {
+		return 1, nil
+	}
+	version, err := strconv.Atoi(header.SchemaVersion.String())
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("schemaVersion 必须是正整数: %s", header.SchemaVersion)
+	}
+	if version > CurrentSchemaVersion {
+		return 0, &SchemaVersionError{Version: version, Supported: CurrentSchemaVersion}
+	}
+	return version, nil
+}
+
+// ValidateSchema 按 Schema() 描述的规则校验年份数据文件
+// 校验通过返回 nil，否则返回包含全部问题的 *ValidationError
+func ValidateSchema(jsonData []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	dec.UseNumber()
+
+	var root any
+	if err := dec.Decode(&root); err != nil {
+		return &ValidationError{Issues: []ValidationIssue{{Message: fmt.Sprintf("JSON 格式错误: %v", err)}}}
+	}
+
+	obj, ok := root.(map[string]any)
+	if !ok {
+		return &ValidationError{Issues: []ValidationIssue{{Message: "顶层必须是 JSON 对象"}}}
+	}
+
+	// 格式版本不受支持时其余字段的含义未知，不再继续校验
+	if _, err := schemaVersion(jsonData); err != nil {
+		return &ValidationError{Issues: []ValidationIssue{{Path: "schemaVersion", Message: err.Error()}}}
+	}
+
+	var issues []ValidationIssue
+	for _, field := range schemaRequiredFields {
+		if _, exists := obj[field]; !exists {
+			issues = append(issues, ValidationIssue{Path: field, Message: "缺少必填字段"})
+		}
+	}
+
+	for _, field := range sortedKeys(obj) {
+		if !schemaFields[field] {
+			issues = append(issues, ValidationIssue{Path: field, Message: "未知字段"})
+			continue
+		}
+		if field == "schemaVersion" {
+			continue
+		}
+		issues = append(issues, validateDateNames(field, obj[field])...)
+	}
+
+	if len(issues) > 0 {
+		return &ValidationError{Issues: issues}
+	}
+	return nil
+}
+
+// ValidateYear 在 ValidateSchema 的基础上，校验所有日期都属于 year 年
+func ValidateYear(year int, jsonData []byte) error {
+	if err := ValidateSchema(jsonData); err != nil {
+		return err
+	}
+
+	var data HolidayData
+	if err := json.Unmarshal(jsonData, &data); err != nil {
+		return &ValidationError{Issues: []ValidationIssue{{Message: fmt.Sprintf("JSON 格式错误: %v", err)}}}
+	}
+
+	prefix := fmt.Sprintf("%04d-", year)
+	var issues []ValidationIssue
+	for _, field := range []struct {
+		name    string
+		entries map[string]string
+	}{
+		{"holidays", data.Holidays},
+		{"workdays", data.Workdays},
+		{"inLieuDays", data.InLieuDays},
+	} {
+		for _, date := range sortedKeys(field.entries) {
+			// 跨年调休的工作日可以属于相邻年份
+			if !strings.HasPrefix(date, prefix) && !(field.name == "workdays" && isAdjacentYear(year, date)) {
+				issues = append(issues, ValidationIssue{
+					Path:    field.name + "." + date,
+					Message: fmt.Sprintf("日期不属于 %d 年", year),
+				})
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		return &ValidationError{Issues: issues}
+	}
+	return nil
+}
+
+// validateDateNames 校验 日期 -> 名称 映射
+func validateDateNames(field string, value any) []ValidationIssue {
+	entries, ok := value.(map[string]any)
+	if !ok {
+		return []ValidationIssue{{Path: field, Message: "必须是 JSON 对象"}}
+	}
+
+	var issues []ValidationIssue
+	for _, date := range sortedKeys(entries) {
+		path := field + "." + date
+		if _, err := time.Parse("2006-01-02", date); err != nil || len(date) != 10 {
+			issues = append(issues, ValidationIssue{Path: path, Message: "日期格式无效，应为 YYYY-MM-DD"})
+		}
+		name, ok := entries[date].(string)
+		if !ok {
+			issues = append(issues, ValidationIssue{Path: path, Message: "名称必须是字符串"})
+		} else if name == "" {
+			issues = append(issues, ValidationIssue{Path: path, Message: "名称不能为空"})
+		}
+	}
+	return issues
+}
+
+// sortedKeys 返回按字典序排列的 map 键
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,156 @@
+package cnholiday
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSchemaDocument(t *testing.T) {
+	var doc struct {
+		Required   []string                   `json:"required"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(Schema(), &doc); err != nil {
+		t.Fatalf("schema is not valid JSON: %v", err)
+	}
+	if len(doc.Required) != len(schemaRequiredFields) {
+		t.Errorf("schema required = %v, validator requires %v", doc.Required, schemaRequiredFields)
+	}
+	for field := range schemaFields {
+		if _, ok := doc.Properties[field]; !ok {
+			t.Errorf("schema missing property %q", field)
+		}
+	}
+}
+
+func TestValidateSchemaEmbedded(t *testing.T) {
+	for _, year := range []int{2024, 2025, 2026} {
+		raw, err := embeddedData.ReadFile(fmt.Sprintf("data/%d.json", year))
+		if err != nil {
+			t.Fatalf("read embedded %d: %v", year, err)
+		}
+		if err := ValidateSchema(raw); err != nil {
+			t.Errorf("embedded %d should be valid: %v", year, err)
+		}
+	}
+}
+
+func TestValidateSchemaInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		issues int
+	}{
+		{"非 JSON", `{invalid`, 1},
+		{"顶层数组", `[]`, 1},
+		{"缺少字段", `{"holidays": {}}`, 1},
+		{"未知字段", `{"holidays": {}, "workdays": {}, "holiday": {}}`, 1},
+		{"非法日期", `{"holidays": {"2026-13-01": "元旦", "2026-02-30": "春节"}, "workdays": {}}`, 2},
+		{"日期格式", `{"holidays": {"2026-1-1": "元旦"}, "workdays": {}}`, 1},
+		{"名称类型", `{"holidays": {"2026-01-01": 1}, "workdays": {"2026-01-04": ""}}`, 2},
+		{"字段类型", `{"holidays": [], "workdays": {}}`, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSchema([]byte(tt.data))
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("expected *ValidationError, got %v", err)
+			}
+			if len(verr.Issues) != tt.issues {
+				t.Errorf("len(Issues) = %d, want %d: %v", len(verr.Issues), tt.issues, verr)
+			}
+		})
+	}
+}
+
+func TestValidateYear(t *testing.T) {
+	valid := []byte(`{"holidays": {"2026-01-01": "元旦"}, "workdays": {"2026-01-04": "元旦"}}`)
+	if err := ValidateYear(2026, valid); err != nil {
+		t.Errorf("ValidateYear(2026) failed: %v", err)
+	}
+
+	// 调休工作日可以属于相邻年份，节假日不可以
+	err := ValidateYear(2025, valid)
+	var verr *ValidationError
+	if !errors.As(err, &verr) || len(verr.Issues) != 1 || verr.Issues[0].Path != "holidays.2026-01-01" {
+		t.Errorf("ValidateYear(2025) = %v, want 1 issue", err)
+	}
+	if err := ValidateYear(2024, valid); !errors.As(err, &verr) || len(verr.Issues) != 2 {
+		t.Errorf("ValidateYear(2024) = %v, want 2 issues", err)
+	}
+}
+
+func TestStrictValidation(t *testing.T) {
+	typo := []byte(`{"holidays": {"2026-13-01": "元旦"}, "workdays": {}, "inLieuDays": {}}`)
+	otherYear := []byte(`{"holidays": {"2025-01-01": "元旦"}, "workdays": {}, "inLieuDays": {}}`)
+
+	// 默认不校验，保持原有行为
+	checker := NewChecker()
+	if err := checker.LoadYearFromJSON(2026, typo); err != nil {
+		t.Errorf("non-strict LoadYearFromJSON should accept data: %v", err)
+	}
+
+	strict := NewCheckerWithConfig(Config{StrictValidation: true})
+	if err := strict.LoadYearFromJSON(2026, typo); err == nil {
+		t.Error("strict LoadYearFromJSON should reject malformed date")
+	}
+	if err := strict.LoadYearFromJSON(2026, otherYear); err == nil {
+		t.Error("strict LoadYearFromJSON should reject dates from another year")
+	}
+	if strict.IsYearLoaded(2026) {
+		t.Error("rejected data should not be cached")
+	}
+
+	// 内置数据可以通过严格校验
+	strict.SetDisableRemote(true)
+	if err := strict.LoadYear(2026); err != nil {
+		t.Errorf("embedded data should pass strict validation: %v", err)
+	}
+}
+
+func TestSchemaVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{"missing", `{"holidays": {"2030-10-01": "国庆节"}, "workdays": {}}`, false},
+		{"current", `{"schemaVersion": 1, "holidays": {"2030-10-01": "国庆节"}, "workdays": {}}`, false},
+		{"zero", `{"schemaVersion": 0, "holidays": {}, "workdays": {}}`, true},
+		{"fraction", `{"schemaVersion": 1.5, "holidays": {}, "workdays": {}}`, true},
+		{"string", `{"schemaVersion": "v1", "holidays": {}, "workdays": {}}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, strict := range []bool{false, true} {
+				checker := NewCheckerWithConfig(Config{DisableRemote: true, StrictValidation: strict})
+				if err := checker.LoadYearFromJSON(2030, []byte(tt.data)); (err != nil) != tt.wantErr {
+					t.Errorf("strict=%v LoadYearFromJSON error = %v, wantErr %v", strict, err, tt.wantErr)
+				}
+			}
+			if err := ValidateSchema([]byte(tt.data)); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSchema error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// 新版本的条目格式可能与当前结构不兼容，应提示升级而不是报告 JSON 类型错误
+	newer := []byte(`{"schemaVersion": 2, "holidays": {"2030-10-01": {"name": "国庆节", "type": "statutory"}}, "workdays": {}}`)
+	for _, strict := range []bool{false, true} {
+		checker := NewCheckerWithConfig(Config{DisableRemote: true, StrictValidation: strict})
+		err := checker.LoadYearFromJSON(2030, newer)
+		var versionErr *SchemaVersionError
+		if !errors.As(err, &versionErr) || versionErr.Version != 2 || versionErr.Supported != CurrentSchemaVersion {
+			t.Errorf("strict=%v LoadYearFromJSON error = %v, want *SchemaVersionError", strict, err)
+		}
+	}
+	var validationErr *ValidationError
+	if err := ValidateSchema(newer); !errors.As(err, &validationErr) || len(validationErr.Issues) != 1 ||
+		validationErr.Issues[0].Path != "schemaVersion" {
+		t.Errorf("ValidateSchema(newer) error = %v, want a single schemaVersion issue", err)
+	}
+}
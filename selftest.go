@@ -0,0 +1,70 @@
+package cnholiday
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"time"
+)
+
+// SourceCheck 单个数据源的自检结果
+type SourceCheck struct {
+	Source   Source
+	Duration time.Duration
+	Err      error // 无法访问或解析失败的原因，通过时为 nil
+	Warning  error // 不影响通过的问题，例如嵌入数据尚未包含该年份，需要升级库版本
+}
+
+// SelfTestReport 自检报告
+type SelfTestReport struct {
+	Year   int           // 自检使用的年份，即当前年份
+	Checks []SourceCheck // 按数据源优先级排列
+}
+
+// OK 是否所有数据源都通过自检，Warning 不影响结果
+func (r *SelfTestReport) OK() bool {
+	for _, check := range r.Checks {
+		if check.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// SelfTest 逐个检查已配置的数据源能否访问并解析当前年份的数据，任一数据源失败时返回错误
+// 建议在 main() 中调用，使 LocalDataDir 路径错误、CDN 被屏蔽等配置问题在部署时暴露，而不是等到月底结算时才发现。
+// 嵌入数据随库版本发布，不包含当前年份时只记为 Warning，避免新年第一天所有实例启动失败。
+// 自检不会写入缓存，也不会改变 LoadReport；远程请求绕过熔断器和限流器，失败不会导致正常查询被熔断
+func (c *Checker) SelfTest(ctx context.Context) (*SelfTestReport, error) {
+	return c.selfTest(ctx, time.Now().Year())
+}
+
+// selfTest 检查各数据源能否提供 year 年的数据
+func (c *Checker) selfTest(ctx context.Context, year int) (*SelfTestReport, error) {
+	report := &SelfTestReport{Year: year}
+	var errs []error
+	for _, source := range c.sources() {
+		start := time.Now()
+		err := ctx.Err()
+		if err == nil {
+			_, err = c.probeYearFrom(ctx, source, year)
+		}
+		check := SourceCheck{Source: source, Duration: time.Since(start), Err: err}
+		if source == SourceEmbedded && errors.Is(err, fs.ErrNotExist) {
+			check.Err, check.Warning = nil, err
+		}
+		report.Checks = append(report.Checks, check)
+		if check.Err != nil {
+			errs = append(errs, &SourceError{Source: source, Err: err})
+		}
+	}
+
+	if len(report.Checks) == 0 {
+		return report, errors.New("自检失败: 未配置数据源")
+	}
+	if len(errs) > 0 {
+		return report, fmt.Errorf("自检失败: %w", errors.Join(errs...))
+	}
+	return report, nil
+}
@@ -0,0 +1,81 @@
+package cnholiday
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSelfTest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL, LocalDataDir: "/nonexistent"})
+	report, err := checker.selfTest(context.Background(), 2026)
+	if err == nil || report.OK() {
+		t.Fatalf("selfTest = %+v, %v; want failure", report, err)
+	}
+	var srcErr *SourceError
+	if !errors.As(err, &srcErr) || srcErr.Source != SourceRemote {
+		t.Errorf("error = %v", err)
+	}
+
+	want := []struct {
+		source Source
+		ok     bool
+	}{{SourceRemote, false}, {SourceLocal, false}, {SourceEmbedded, true}}
+	if len(report.Checks) != len(want) {
+		t.Fatalf("Checks = %+v", report.Checks)
+	}
+	for i, w := range want {
+		if got := report.Checks[i]; got.Source != w.source || (got.Err == nil) != w.ok {
+			t.Errorf("Checks[%d] = %+v, want source %s ok=%v", i, got, w.source, w.ok)
+		}
+	}
+	if checker.IsYearLoaded(2026) {
+		t.Error("selfTest should not populate the cache")
+	}
+
+	healthy := NewCheckerWithConfig(Config{DisableRemote: true})
+	if report, err := healthy.selfTest(context.Background(), 2026); err != nil || !report.OK() {
+		t.Errorf("selfTest = %+v, %v", report, err)
+	}
+}
+
+func TestSelfTestDoesNotAffectQueries(t *testing.T) {
+	// 嵌入数据尚未包含的年份只是警告
+	embedded := NewCheckerWithConfig(Config{DisableRemote: true})
+	report, err := embedded.selfTest(context.Background(), 2099)
+	if err != nil || !report.OK() || report.Checks[0].Warning == nil {
+		t.Errorf("selfTest(2099) = %+v, %v; want OK with warning", report, err)
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL:       server.URL,
+		BreakerThreshold: 1,
+		RemoteRateLimit:  0.001,
+	})
+	for range 3 {
+		if _, err := checker.selfTest(context.Background(), 2026); err == nil {
+			t.Fatal("selfTest should fail for an unavailable CDN")
+		}
+	}
+	if requests != 3 {
+		t.Errorf("requests = %d, want 3 (probes bypass the rate limiter)", requests)
+	}
+	// 自检失败没有触发熔断，也没有占用限流令牌
+	if !checker.breaker.allow() || !checker.limiter.allow(server.URL) {
+		t.Error("selfTest should not affect the breaker or the rate limiter")
+	}
+}
@@ -0,0 +1,110 @@
+package cnholiday
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DayResponse HTTP 服务 /v1/days/{date} 接口的响应
+type DayResponse struct {
+	Date        string  `json:"date"`
+	Type        DayType `json:"type"`
+	Name        string  `json:"name,omitempty"`
+	IsWorkday   bool    `json:"isWorkday"`
+	Source      Source  `json:"source"`
+	DataVersion int     `json:"dataVersion"`
+}
+
+// NewHandler 返回基于检查器的 HTTP 服务，提供以下接口：
+//
+//	GET /v1/years/{year}.json  年份数据，格式与本地数据文件相同，可作为 CDNBaseURL 或 RemoteChecker 的数据源
+//	GET /v1/days/{date}        单日查询，date 格式为 2006-01-02，返回 DayResponse
+//
+// 年份超出范围或所有数据源都没有该年份时返回 404，数据源故障返回 502，熔断、限流或超时返回 503；
+// 错误详情可能包含内部地址和文件路径，不返回给客户端
+func NewHandler(c *Checker) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/years/{file}", func(w http.ResponseWriter, r *http.Request) {
+		year, err := strconv.Atoi(strings.TrimSuffix(r.PathValue("file"), ".json"))
+		if err != nil || !strings.HasSuffix(r.PathValue("file"), ".json") {
+			http.Error(w, "年份格式无效", http.StatusBadRequest)
+			return
+		}
+		idx, err := c.indexContext(r.Context(), year)
+		if err != nil {
+			writeLoadError(w, err)
+			return
+		}
+		data := idx.holidayData()
+		data.SchemaVersion = CurrentSchemaVersion
+		writeJSON(w, data)
+	})
+	mux.HandleFunc("GET /v1/days/{date}", func(w http.ResponseWriter, r *http.Request) {
+		date, err := time.Parse("2006-01-02", r.PathValue("date"))
+		if err != nil {
+			http.Error(w, "日期格式无效", http.StatusBadRequest)
+			return
+		}
+		var info HolidayInfo
+		if err := c.getHolidayInfoContext(r.Context(), date, &info); err != nil {
+			writeLoadError(w, err)
+			return
+		}
+		dayType := info.DayType()
+		writeJSON(w, DayResponse{
+			Date:        date.Format("2006-01-02"),
+			Type:        dayType,
+			Name:        info.HolidayName,
+			IsWorkday:   info.IsWorkday,
+			Source:      info.Source,
+			DataVersion: info.DataVersion,
+		})
+	})
+	return mux
+}
+
+// writeLoadError 按年份数据加载失败的原因返回状态码和通用的提示
+func writeLoadError(w http.ResponseWriter, err error) {
+	var rangeErr *YearRangeError
+	var loadErr *YearLoadError
+	switch {
+	case errors.As(err, &rangeErr):
+		http.Error(w, "年份超出范围", http.StatusNotFound)
+	case errors.As(err, &loadErr) && allNotFound(loadErr.Errors):
+		http.Error(w, "该年份的数据不存在", http.StatusNotFound)
+	case errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrRateLimited) || errors.Is(err, context.DeadlineExceeded):
+		http.Error(w, "数据源暂时不可用", http.StatusServiceUnavailable)
+	default:
+		http.Error(w, "数据源加载失败", http.StatusBadGateway)
+	}
+}
+
+// allNotFound 判断是否所有数据源都明确表示没有该年份的数据
+func allNotFound(errs []*SourceError) bool {
+	for _, err := range errs {
+		if !isNotFound(err) {
+			return false
+		}
+	}
+	return len(errs) > 0
+}
+
+// isNotFound 判断数据源的错误是否表示没有该年份的数据
+func isNotFound(err error) bool {
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusNotFound || statusErr.StatusCode == http.StatusGone
+	}
+	return errors.Is(err, fs.ErrNotExist) || errors.Is(err, ErrKeyNotFound)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,117 @@
+package cnholiday
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	server := httptest.NewServer(NewHandler(NewCheckerWithConfig(Config{DisableRemote: true})))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/v1/days/2026-10-10")
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	var day DayResponse
+	json.NewDecoder(resp.Body).Decode(&day)
+	resp.Body.Close()
+	if day.Type != DayTypeAdjustedWorkday || !day.IsWorkday || day.Source != SourceEmbedded {
+		t.Errorf("day = %+v", day)
+	}
+
+	resp, err = http.Get(server.URL + "/v1/years/2026.json")
+	if err != nil {
+		t.Fatalf("GET failed: %v", err)
+	}
+	var data HolidayData
+	json.NewDecoder(resp.Body).Decode(&data)
+	resp.Body.Close()
+	if data.Holidays["2026-10-01"] == "" || data.Workdays["2026-10-10"] == "" {
+		t.Errorf("year data missing entries: %d holidays, %d workdays", len(data.Holidays), len(data.Workdays))
+	}
+
+	for path, status := range map[string]int{
+		"/v1/days/2026-13-01": http.StatusBadRequest,
+		"/v1/days/1990-01-01": http.StatusNotFound,
+		"/v1/years/2026":      http.StatusBadRequest,
+		"/v1/years/1990.json": http.StatusNotFound,
+	} {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s failed: %v", path, err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != status {
+			t.Errorf("GET %s = %d, want %d", path, resp.StatusCode, status)
+		}
+	}
+}
+
+func TestHandlerLoadErrors(t *testing.T) {
+	status := http.StatusNotFound
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer upstream.Close()
+
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: upstream.URL, LocalDataDir: "/nonexistent"})
+	server := httptest.NewServer(NewHandler(checker))
+	defer server.Close()
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("GET %s failed: %v", path, err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+
+	// 所有数据源都没有该年份
+	if code, _ := get("/v1/years/2099.json"); code != http.StatusNotFound {
+		t.Errorf("unpublished year = %d, want 404", code)
+	}
+
+	// 上游故障不是 404，且不向客户端暴露内部地址和路径
+	status = http.StatusInternalServerError
+	code, body := get("/v1/years/2098.json")
+	if code != http.StatusBadGateway {
+		t.Errorf("upstream failure = %d, want 502", code)
+	}
+	if strings.Contains(body, upstream.URL) || strings.Contains(body, "/nonexistent") {
+		t.Errorf("response leaks internal details: %q", body)
+	}
+	if code, _ := get("/v1/days/2097-01-01"); code != http.StatusBadGateway {
+		t.Errorf("day upstream failure = %d, want 502", code)
+	}
+}
+
+func TestHandlerRequestContext(t *testing.T) {
+	// 开启 HeuristicFallback 时，请求已超时仍不按周末推断作答
+	checker := NewCheckerWithConfig(Config{DisableRemote: true, HeuristicFallback: true})
+	handler := NewHandler(checker)
+
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	for _, path := range []string{"/v1/days/2027-03-01", "/v1/years/2027.json"} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil).WithContext(ctx))
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Errorf("%s with expired request = %d, want 503", path, rec.Code)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/days/2027-03-01", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("heuristic answer = %d, want 200", rec.Code)
+	}
+}
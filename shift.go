@@ -0,0 +1,90 @@
+package cnholiday
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ShiftPattern 轮班模式，例如"做四休三"、"四班三倒"中某个班组的排班
+// 以 Anchor 为周期起点按 Cycle 循环，SetException 设置的例外日期优先于周期规则
+type ShiftPattern struct {
+	Anchor         time.Time // 周期起始日，当天对应 Cycle[0]
+	Cycle          []bool    // 周期内每天是否上班
+	RestOnHolidays bool      // 法定节假日(含放假期间)是否休息
+
+	exceptions map[int]bool // 例外日期(dayNumber) -> 是否上班
+}
+
+// ParseShiftPattern 解析轮班周期描述创建轮班模式
+// cycle 中 '1'/'班' 表示上班，'0'/'休' 表示休息，例如"做四休三"写作 "1111000" 或 "班班班班休休休"
+func ParseShiftPattern(anchor time.Time, cycle string) (*ShiftPattern, error) {
+	days := make([]bool, 0, len(cycle))
+	for _, r := range cycle {
+		switch r {
+		case '1', '班':
+			days = append(days, true)
+		case '0', '休':
+			days = append(days, false)
+		default:
+			return nil, fmt.Errorf("无效的轮班周期字符 %q", r)
+		}
+	}
+	if len(days) == 0 {
+		return nil, errors.New("轮班周期不能为空")
+	}
+	return &ShiftPattern{Anchor: anchor, Cycle: days}, nil
+}
+
+// SetException 设置例外日期，覆盖周期规则和节假日规则
+func (p *ShiftPattern) SetException(date time.Time, working bool) {
+	if p.exceptions == nil {
+		p.exceptions = make(map[int]bool)
+	}
+	p.exceptions[dayNumber(date)] = working
+}
+
+// Offset 返回周期错开 days 天的新模式，用于描述同一轮班制度下的不同班组
+// 例如 A 班的模式 Offset(2) 后即为晚两天开始周期的 B 班，例外日期不会被复制
+func (p *ShiftPattern) Offset(days int) *ShiftPattern {
+	return &ShiftPattern{
+		Anchor:         p.Anchor.AddDate(0, 0, days),
+		Cycle:          append([]bool(nil), p.Cycle...),
+		RestOnHolidays: p.RestOnHolidays,
+	}
+}
+
+// cycleWorking 仅按周期规则判断指定日期是否上班
+func (p *ShiftPattern) cycleWorking(date time.Time) bool {
+	n := len(p.Cycle)
+	offset := (dayNumber(date) - dayNumber(p.Anchor)) % n
+	if offset < 0 {
+		offset += n
+	}
+	return p.Cycle[offset]
+}
+
+// IsShiftWorking 判断轮班模式下指定日期是否上班
+// 判断顺序：例外日期 > 法定节假日(RestOnHolidays 为 true 时) > 轮班周期，
+// 轮班模式不受周末和调休影响
+func (c *Checker) IsShiftWorking(pattern *ShiftPattern, date time.Time) (bool, error) {
+	if len(pattern.Cycle) == 0 {
+		return false, errors.New("轮班周期不能为空")
+	}
+
+	if working, ok := pattern.exceptions[dayNumber(date)]; ok {
+		return working, nil
+	}
+
+	if pattern.RestOnHolidays {
+		info, err := c.GetHolidayInfo(date)
+		if err != nil {
+			return false, err
+		}
+		if info.IsHoliday && !info.IsWeekend {
+			return false, nil
+		}
+	}
+
+	return pattern.cycleWorking(date), nil
+}
@@ -0,0 +1,82 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseShiftPattern(t *testing.T) {
+	anchor := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	p1, err := ParseShiftPattern(anchor, "1111000")
+	if err != nil {
+		t.Fatalf("ParseShiftPattern failed: %v", err)
+	}
+	p2, err := ParseShiftPattern(anchor, "班班班班休休休")
+	if err != nil {
+		t.Fatalf("ParseShiftPattern failed: %v", err)
+	}
+	if len(p1.Cycle) != 7 || len(p2.Cycle) != 7 {
+		t.Fatalf("cycle length = %d, %d; want 7", len(p1.Cycle), len(p2.Cycle))
+	}
+	for i := range p1.Cycle {
+		if p1.Cycle[i] != p2.Cycle[i] {
+			t.Errorf("cycle[%d] mismatch", i)
+		}
+	}
+
+	if _, err := ParseShiftPattern(anchor, ""); err == nil {
+		t.Error("expected error for empty cycle")
+	}
+	if _, err := ParseShiftPattern(anchor, "11x0"); err == nil {
+		t.Error("expected error for invalid character")
+	}
+}
+
+func TestIsShiftWorking(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	jsonData := []byte(`{
+		"holidays": {"2026-01-01": "元旦", "2026-01-03": "元旦"},
+		"workdays": {},
+		"inLieuDays": {}
+	}`)
+	if err := checker.LoadYearFromJSON(2026, jsonData); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	// A 班 2025-12-29 起做四休三
+	crewA, _ := ParseShiftPattern(time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC), "1111000")
+	crewB := crewA.Offset(4)
+	crewA.RestOnHolidays = true
+	crewA.SetException(time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC), true)
+
+	tests := []struct {
+		name     string
+		pattern  *ShiftPattern
+		date     string
+		expected bool
+	}{
+		{"A 周期内上班", crewA, "2025-12-30", true},
+		{"A 法定节假日休息", crewA, "2026-01-01", false},
+		{"A 周期内休息", crewA, "2026-01-02", false},
+		{"A 例外加班", crewA, "2026-01-04", true},
+		{"A 周期第二轮", crewA, "2026-01-05", true},
+		{"A 锚点之前", crewA, "2025-12-28", false},
+		{"B 错开四天", crewB, "2026-01-02", true},
+		{"B 节假日照常上班", crewB, "2026-01-03", true},
+		{"B 周期内休息", crewB, "2026-01-06", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, _ := time.Parse("2006-01-02", tt.date)
+			working, err := checker.IsShiftWorking(tt.pattern, date)
+			if err != nil {
+				t.Fatalf("IsShiftWorking failed: %v", err)
+			}
+			if working != tt.expected {
+				t.Errorf("IsShiftWorking(%s) = %v, want %v", tt.date, working, tt.expected)
+			}
+		})
+	}
+}
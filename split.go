@@ -0,0 +1,44 @@
+package cnholiday
+
+import "time"
+
+// DayTypeRun 一段日期类型相同的连续日期
+type DayTypeRun struct {
+	Start time.Time // 第一天
+	End   time.Time // 最后一天(包含)
+	Type  DayType
+	Days  int // 天数
+}
+
+// SplitByDayType 将 [start, end] 闭区间内的日期按日期类型切分为若干连续子区间
+// 相邻日期类型相同时合并为一段，例如国庆假期中的周末与节假日属于同一类型，会合并为一段
+func (c *Checker) SplitByDayType(start, end time.Time) ([]DayTypeRun, error) {
+	return c.SplitByDayTypeIn(DateRange{Start: start, End: end})
+}
+
+// SplitByDayTypeIn 将区间按日期类型切分为若干连续子区间，参见 SplitByDayType
+func (c *Checker) SplitByDayTypeIn(r DateRange) ([]DayTypeRun, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	var runs []DayTypeRun
+	for date := range r.All() {
+		dayType, _, err := c.Classify(date)
+		if err != nil {
+			return nil, err
+		}
+		if n := len(runs); n > 0 && runs[n-1].Type == dayType {
+			runs[n-1].End = date
+			runs[n-1].Days++
+			continue
+		}
+		runs = append(runs, DayTypeRun{Start: date, End: date, Type: dayType, Days: 1})
+	}
+	return runs, nil
+}
+
+// Range 返回该段连续日期的区间
+func (run DayTypeRun) Range() DateRange {
+	return DateRange{Start: run.Start, End: run.End}
+}
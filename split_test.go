@@ -0,0 +1,53 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSplitByDayType(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	start := time.Date(2026, 9, 28, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC)
+
+	runs, err := checker.SplitByDayType(start, end)
+	if err != nil {
+		t.Fatalf("SplitByDayType failed: %v", err)
+	}
+
+	want := []struct {
+		start, end string
+		dayType    DayType
+	}{
+		{"2026-09-28", "2026-09-30", DayTypeWorkday},
+		{"2026-10-01", "2026-10-05", DayTypePublicHoliday},
+		{"2026-10-06", "2026-10-07", DayTypeInLieu},
+		{"2026-10-08", "2026-10-09", DayTypeWorkday},
+		{"2026-10-10", "2026-10-10", DayTypeAdjustedWorkday},
+		{"2026-10-11", "2026-10-11", DayTypeWeekend},
+	}
+	if len(runs) != len(want) {
+		t.Fatalf("len(runs) = %d, want %d: %+v", len(runs), len(want), runs)
+	}
+
+	total := 0
+	for i, w := range want {
+		r := runs[i]
+		if r.Start.Format("2006-01-02") != w.start || r.End.Format("2006-01-02") != w.end || r.Type != w.dayType {
+			t.Errorf("runs[%d] = %s~%s %v, want %s~%s %v", i,
+				r.Start.Format("2006-01-02"), r.End.Format("2006-01-02"), r.Type, w.start, w.end, w.dayType)
+		}
+		total += r.Days
+	}
+	if total != 14 {
+		t.Errorf("total days = %d, want 14", total)
+	}
+
+	if _, err := checker.SplitByDayType(end, start); err == nil {
+		t.Error("expected error when end is before start")
+	}
+}
@@ -0,0 +1,149 @@
+package cnholiday
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// SQLDialect 生成 SQL 语句使用的数据库方言
+type SQLDialect string
+
+const (
+	SQLDialectMySQL      SQLDialect = "mysql"      // MySQL / MariaDB
+	SQLDialectPostgres   SQLDialect = "postgres"   // PostgreSQL
+	SQLDialectClickHouse SQLDialect = "clickhouse" // ClickHouse，使用 ReplacingMergeTree 表，可作为字典数据源
+)
+
+// sqlBatchSize 每条 INSERT 语句包含的行数
+const sqlBatchSize = 500
+
+// sqlTableName 允许的表名，可以带库名或 schema 前缀
+var sqlTableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// sqlDialects 各方言的建表语句、插入语句后缀、布尔值写法及是否使用事务
+var sqlDialects = map[SQLDialect]struct {
+	create      string
+	upsert      func(table string) string
+	boolean     func(bool) string
+	transaction bool
+}{
+	SQLDialectMySQL: {
+		create: "CREATE TABLE IF NOT EXISTS %s (\n" +
+			"  date DATE NOT NULL PRIMARY KEY,\n" +
+			"  day_type VARCHAR(32) NOT NULL,\n" +
+			"  holiday_name VARCHAR(64) NOT NULL,\n" +
+			"  is_workday TINYINT(1) NOT NULL\n" +
+			");\n",
+		upsert: func(string) string {
+			return "ON DUPLICATE KEY UPDATE day_type = VALUES(day_type), holiday_name = VALUES(holiday_name), is_workday = VALUES(is_workday)"
+		},
+		boolean: func(b bool) string {
+			if b {
+				return "1"
+			}
+			return "0"
+		},
+		transaction: true,
+	},
+	SQLDialectPostgres: {
+		create: "CREATE TABLE IF NOT EXISTS %s (\n" +
+			"  date DATE NOT NULL PRIMARY KEY,\n" +
+			"  day_type VARCHAR(32) NOT NULL,\n" +
+			"  holiday_name VARCHAR(64) NOT NULL,\n" +
+			"  is_workday BOOLEAN NOT NULL\n" +
+			");\n",
+		// 只更新内容发生变化的行，重复执行不会产生多余的写入
+		upsert: func(table string) string {
+			return "ON CONFLICT (date) DO UPDATE SET day_type = EXCLUDED.day_type, holiday_name = EXCLUDED.holiday_name, is_workday = EXCLUDED.is_workday\n" +
+				fmt.Sprintf("WHERE (%[1]s.day_type, %[1]s.holiday_name, %[1]s.is_workday) IS DISTINCT FROM (EXCLUDED.day_type, EXCLUDED.holiday_name, EXCLUDED.is_workday)", table)
+		},
+		boolean: func(b bool) string {
+			if b {
+				return "TRUE"
+			}
+			return "FALSE"
+		},
+		transaction: true,
+	},
+	SQLDialectClickHouse: {
+		// ReplacingMergeTree 按日期去重，重复导入后以最后写入的行为准，查询或字典数据源使用 FINAL
+		create: "CREATE TABLE IF NOT EXISTS %s (\n" +
+			"  date Date,\n" +
+			"  day_type LowCardinality(String),\n" +
+			"  holiday_name String,\n" +
+			"  is_workday UInt8\n" +
+			") ENGINE = ReplacingMergeTree ORDER BY date;\n",
+		boolean: func(b bool) string {
+			if b {
+				return "1"
+			}
+			return "0"
+		},
+	},
+}
+
+// WriteSQLUpsert 输出将 startYear 至 endYear 年(含)每一天的日期类型同步到数据库表的 SQL 语句
+// 包含建表语句(表不存在时)和按日期主键的幂等插入/更新语句，可直接通过 mysql、psql、clickhouse-client 执行，
+// 使数据库中 is_workday 等函数使用的数据与本库保持一致。表结构为 date、day_type、holiday_name、is_workday。
+// 任意日期区间使用 WriteSQLUpsertIn
+func (c *Checker) WriteSQLUpsert(w io.Writer, dialect SQLDialect, table string, startYear, endYear int) error {
+	if endYear < startYear {
+		return errors.New("结束年份早于开始年份")
+	}
+	return c.WriteSQLUpsertIn(w, dialect, table, DateRange{Start: YearRange(startYear).Start, End: YearRange(endYear).End})
+}
+
+// WriteSQLUpsertIn 输出将区间内每一天的日期类型同步到数据库表的 SQL 语句，格式与 WriteSQLUpsert 相同，
+// 只插入或更新区间内的日期，不影响表中的其他日期
+func (c *Checker) WriteSQLUpsertIn(w io.Writer, dialect SQLDialect, table string, r DateRange) error {
+	spec, ok := sqlDialects[dialect]
+	if !ok {
+		return fmt.Errorf("不支持的数据库方言: %s", dialect)
+	}
+	if !sqlTableName.MatchString(table) {
+		return fmt.Errorf("表名无效: %s", table)
+	}
+
+	rows, err := c.DateDimensionIn(r)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, spec.create, table)
+	if spec.transaction {
+		bw.WriteString("BEGIN;\n")
+	}
+	for start := 0; start < len(rows); start += sqlBatchSize {
+		batch := rows[start:min(start+sqlBatchSize, len(rows))]
+		fmt.Fprintf(bw, "INSERT INTO %s (date, day_type, holiday_name, is_workday) VALUES\n", table)
+		for i, row := range batch {
+			if i > 0 {
+				bw.WriteString(",\n")
+			}
+			fmt.Fprintf(bw, "('%s', '%s', %s, %s)", row.Date.Format("2006-01-02"), row.DayType,
+				sqlQuote(dialect, row.HolidayName), spec.boolean(row.IsWorkday))
+		}
+		if spec.upsert != nil {
+			bw.WriteString("\n")
+			bw.WriteString(spec.upsert(table))
+		}
+		bw.WriteString(";\n")
+	}
+	if spec.transaction {
+		bw.WriteString("COMMIT;\n")
+	}
+	return bw.Flush()
+}
+
+// sqlQuote 返回字符串字面量，MySQL 与 ClickHouse 默认将反斜杠视为转义符
+func sqlQuote(dialect SQLDialect, s string) string {
+	if dialect != SQLDialectPostgres {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
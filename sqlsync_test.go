@@ -0,0 +1,83 @@
+package cnholiday
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteSQLUpsert(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	tests := []struct {
+		dialect SQLDialect
+		want    []string
+	}{
+		{SQLDialectMySQL, []string{
+			"CREATE TABLE IF NOT EXISTS cn_calendar (",
+			"BEGIN;\nINSERT INTO cn_calendar (date, day_type, holiday_name, is_workday) VALUES\n('2026-01-01', 'public_holiday', '元旦', 0),",
+			"('2026-10-10', 'adjusted_workday', '国庆节', 1)",
+			"ON DUPLICATE KEY UPDATE day_type = VALUES(day_type)",
+			"COMMIT;\n",
+		}},
+		{SQLDialectPostgres, []string{
+			"is_workday BOOLEAN NOT NULL",
+			"('2026-10-10', 'adjusted_workday', '国庆节', TRUE)",
+			"WHERE (cn_calendar.day_type, cn_calendar.holiday_name, cn_calendar.is_workday) IS DISTINCT FROM",
+		}},
+		{SQLDialectClickHouse, []string{
+			"ENGINE = ReplacingMergeTree ORDER BY date",
+			"('2026-10-10', 'adjusted_workday', '国庆节', 1)",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.dialect), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := checker.WriteSQLUpsert(&buf, tt.dialect, "cn_calendar", 2026, 2026); err != nil {
+				t.Fatalf("WriteSQLUpsert failed: %v", err)
+			}
+			out := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output missing %q", want)
+				}
+			}
+			if strings.Contains(out, "%!") {
+				t.Errorf("malformed output: %s", out[strings.Index(out, "%!"):])
+			}
+			// 365 行分为 500 行一批，只有一条 INSERT
+			if n := strings.Count(out, "INSERT INTO"); n != 1 {
+				t.Errorf("INSERT count = %d, want 1", n)
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := checker.WriteSQLUpsert(&buf, SQLDialectMySQL, "cal; DROP TABLE x", 2026, 2026); err == nil {
+		t.Error("expected error for invalid table name")
+	}
+	if err := checker.WriteSQLUpsert(&buf, "oracle", "cn_calendar", 2026, 2026); err == nil {
+		t.Error("expected error for unsupported dialect")
+	}
+
+	// 只同步国庆假期前后
+	buf.Reset()
+	r := DateRange{Start: time.Date(2026, 9, 30, 0, 0, 0, 0, time.Local), End: time.Date(2026, 10, 10, 0, 0, 0, 0, time.Local), Bounds: BoundsClosedOpen}
+	if err := checker.WriteSQLUpsertIn(&buf, SQLDialectMySQL, "cn_calendar", r); err != nil {
+		t.Fatalf("WriteSQLUpsertIn failed: %v", err)
+	}
+	out := buf.String()
+	if n := strings.Count(out, "('2026-"); n != 10 || !strings.Contains(out, "('2026-09-30', 'workday'") || strings.Contains(out, "2026-10-10") {
+		t.Errorf("WriteSQLUpsertIn(%s) wrote %d rows:\n%s", r, n, out)
+	}
+}
+
+func TestSQLQuote(t *testing.T) {
+	if got := sqlQuote(SQLDialectMySQL, `a'b\c`); got != `'a''b\\c'` {
+		t.Errorf("mysql quote = %s", got)
+	}
+	if got := sqlQuote(SQLDialectPostgres, `a'b\c`); got != `'a''b\c'` {
+		t.Errorf("postgres quote = %s", got)
+	}
+}
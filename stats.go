@@ -0,0 +1,184 @@
+package cnholiday
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultStatutoryDays 《全国年节及纪念日放假办法》(2025 年起施行)规定的各节日放假天数
+var defaultStatutoryDays = map[Festival]int{
+	FestivalNewYear:        1,
+	FestivalSpringFestival: 4,
+	FestivalQingMing:       1,
+	FestivalLabourDay:      2,
+	FestivalDragonBoat:     1,
+	FestivalMidAutumn:      1,
+	FestivalNationalDay:    3,
+}
+
+// statutoryDays 返回 year 年放假办法规定的节日放假天数，2025 年以前春节为 3 天、劳动节为 1 天
+// 数据中的名称没有 "英文名,中文名,法定天数" 第三段时使用
+func statutoryDays(year int, f Festival) int {
+	if year < 2025 {
+		switch f {
+		case FestivalSpringFestival:
+			return 3
+		case FestivalLabourDay:
+			return 1
+		}
+	}
+	return defaultStatutoryDays[f]
+}
+
+// nameStatutoryDays 返回名称 "英文名,中文名,法定天数" 中的法定天数，没有第三段时返回 false
+func nameStatutoryDays(name string) (int, bool) {
+	parts := strings.Split(name, ",")
+	if len(parts) < 3 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(parts[2])
+	return n, err == nil
+}
+
+// FestivalStat 一个节日的放假天数构成
+type FestivalStat struct {
+	Festival      Festival
+	RestDays      int // 连续放假天数(含假期内的周末和补休日)
+	StatutoryDays int // 法定放假天数
+	WeekendDays   int // 放假期间本来就休息的周末天数
+	BorrowedDays  int // 调休上班的周末天数，即为凑连休借用的周末
+	NetExtraDays  int // 净多休的天数：RestDays - WeekendDays - BorrowedDays，调休安排平衡时等于 StatutoryDays
+}
+
+// FestivalStats 按节日统计 year 年的放假天数构成，按节日的时间顺序排列，只包含数据中有放假安排的节日
+// 合并放假时各节日按自己的子区间统计，例如 2025 年国庆节的 8 天中包含了中秋的 1 天。
+// 调休上班的日期按本年份数据文件中的记录归属，跨年调休(例如次年元旦在本年 12 月的调休)计入发布通知的年份
+func (c *Checker) FestivalStats(year int) ([]FestivalStat, error) {
+	periods, err := c.FestivalPeriods(year)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := c.index(year)
+	if err != nil {
+		return nil, err
+	}
+	data := idx.base.view()
+
+	stats := make(map[Festival]*FestivalStat)
+	for _, p := range periods {
+		stat := stats[p.Festival]
+		if stat == nil {
+			stat = &FestivalStat{Festival: p.Festival, StatutoryDays: statutoryDays(year, p.Festival)}
+			stats[p.Festival] = stat
+		}
+		stat.RestDays += p.Days
+		for day := p.Start; !day.After(p.End); day = day.AddDate(0, 0, 1) {
+			if weekday := day.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+				stat.WeekendDays++
+			}
+		}
+	}
+
+	// 名称格式为 "英文名,中文名,法定天数"，以数据中的法定天数为准
+	for _, name := range data.Holidays {
+		n, ok := nameStatutoryDays(name)
+		if !ok {
+			continue
+		}
+		for _, f := range NormalizeFestival(name) {
+			if stat := stats[f]; stat != nil {
+				stat.StatutoryDays = n
+			}
+		}
+	}
+	for _, name := range data.Workdays {
+		for _, f := range NormalizeFestival(name) {
+			if stat := stats[f]; stat != nil {
+				stat.BorrowedDays++
+			}
+		}
+	}
+
+	var result []FestivalStat
+	for _, f := range festivals {
+		if stat := stats[f]; stat != nil {
+			stat.NetExtraDays = stat.RestDays - stat.WeekendDays - stat.BorrowedDays
+			result = append(result, *stat)
+		}
+	}
+	return result, nil
+}
+
+// YearTrend 一个年份的工作日与放假概况
+type YearTrend struct {
+	Year              int
+	Workdays          int       // 工作日天数(含调休工作日)
+	RestDays          int       // 休息天数(周末、节假日和补休日)
+	HolidayDays       int       // 放假天数(含假期内的周末和补休日)
+	AdjustedWorkdays  int       // 调休工作日天数
+	LongestBreak      int       // 最长连续休息天数(含与假期相连的周末)，按自然年截断
+	LongestBreakStart time.Time // 最长连续休息的第一天，天数相同时取较早的一次
+}
+
+// TrendStats 返回 [fromYear, toYear] 各年份的工作日与放假概况，按年份排列，
+// 用于研究历年放假安排，或将新发布的数据与往年对比
+// 未缓存的年份通过 LoadYears 并发加载，任一年份加载失败时返回 *LoadYearsError
+func (c *Checker) TrendStats(fromYear, toYear int) ([]YearTrend, error) {
+	if toYear < fromYear {
+		return nil, fmt.Errorf("结束年份 %d 早于开始年份 %d", toYear, fromYear)
+	}
+
+	var missing []int
+	for year := fromYear; year <= toYear; year++ {
+		if !c.IsYearLoaded(year) {
+			missing = append(missing, year)
+		}
+	}
+	if len(missing) > 0 {
+		if err := c.LoadYears(context.Background(), missing...); err != nil {
+			return nil, err
+		}
+	}
+
+	trends := make([]YearTrend, 0, toYear-fromYear+1)
+	for year := fromYear; year <= toYear; year++ {
+		idx, err := c.index(year)
+		if err != nil {
+			return nil, err
+		}
+
+		trend := YearTrend{Year: year}
+		var run int
+		var runStart time.Time
+		day := time.Date(year, 1, 1, 0, 0, 0, 0, time.Local)
+		for day.Year() == year {
+			dayType, _ := idx.lookup(day)
+			switch dayType {
+			case DayTypeAdjustedWorkday:
+				trend.AdjustedWorkdays++
+			case DayTypePublicHoliday, DayTypeInLieu:
+				trend.HolidayDays++
+			}
+
+			if dayType == DayTypeWorkday || dayType == DayTypeAdjustedWorkday {
+				trend.Workdays++
+				run = 0
+			} else {
+				trend.RestDays++
+				if run == 0 {
+					runStart = day
+				}
+				run++
+				if run > trend.LongestBreak {
+					trend.LongestBreak, trend.LongestBreakStart = run, runStart
+				}
+			}
+			day = day.AddDate(0, 0, 1)
+		}
+		trends = append(trends, trend)
+	}
+	return trends, nil
+}
@@ -0,0 +1,99 @@
+package cnholiday
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFestivalStats(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	stats, err := checker.FestivalStats(2026)
+	if err != nil {
+		t.Fatalf("FestivalStats failed: %v", err)
+	}
+	if len(stats) != len(festivals) {
+		t.Fatalf("len(FestivalStats(2026)) = %d, want %d", len(stats), len(festivals))
+	}
+
+	want := map[Festival]FestivalStat{
+		FestivalSpringFestival: {Festival: FestivalSpringFestival, RestDays: 9, StatutoryDays: 4, WeekendDays: 3, BorrowedDays: 2, NetExtraDays: 4},
+		FestivalNationalDay:    {Festival: FestivalNationalDay, RestDays: 7, StatutoryDays: 3, WeekendDays: 2, BorrowedDays: 2, NetExtraDays: 3},
+		FestivalDragonBoat:     {Festival: FestivalDragonBoat, RestDays: 3, StatutoryDays: 1, WeekendDays: 2, BorrowedDays: 0, NetExtraDays: 1},
+	}
+	for i, stat := range stats {
+		if stat.Festival != festivals[i] {
+			t.Errorf("stats[%d].Festival = %v, want %v", i, stat.Festival, festivals[i])
+		}
+		if w, ok := want[stat.Festival]; ok && stat != w {
+			t.Errorf("FestivalStats(2026) %v = %+v, want %+v", stat.Festival, stat, w)
+		}
+	}
+
+	if _, err := checker.FestivalStats(1990); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
+
+func TestFestivalStatsDefaultStatutoryDays(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	// 名称中没有法定天数时按放假办法的规定计算
+	jsonData := []byte(`{
+		"holidays": {"2030-05-01": "劳动节", "2030-05-02": "劳动节", "2030-05-03": "劳动节"},
+		"workdays": {"2030-04-28": "劳动节"}
+	}`)
+	if err := checker.LoadYearFromJSON(2030, jsonData); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	stats, err := checker.FestivalStats(2030)
+	if err != nil {
+		t.Fatalf("FestivalStats failed: %v", err)
+	}
+	want := FestivalStat{Festival: FestivalLabourDay, RestDays: 3, StatutoryDays: 2, WeekendDays: 0, BorrowedDays: 1, NetExtraDays: 2}
+	if len(stats) != 1 || stats[0] != want {
+		t.Errorf("FestivalStats(2030) = %+v, want [%+v]", stats, want)
+	}
+}
+
+func TestTrendStats(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	trends, err := checker.TrendStats(2024, 2026)
+	if err != nil {
+		t.Fatalf("TrendStats failed: %v", err)
+	}
+
+	want := []struct {
+		year, workdays, holidays, adjusted, longest int
+		longestStart                                string
+	}{
+		{2024, 251, 28, 8, 8, "2024-02-10"},
+		{2025, 248, 28, 5, 8, "2025-01-28"},
+		{2026, 248, 33, 6, 9, "2026-02-15"},
+	}
+	if len(trends) != len(want) {
+		t.Fatalf("len(TrendStats) = %d, want %d", len(trends), len(want))
+	}
+	for i, w := range want {
+		got := trends[i]
+		if got.Year != w.year || got.Workdays != w.workdays || got.HolidayDays != w.holidays ||
+			got.AdjustedWorkdays != w.adjusted || got.LongestBreak != w.longest ||
+			got.LongestBreakStart.Format("2006-01-02") != w.longestStart {
+			t.Errorf("TrendStats[%d] = %+v, want %+v", i, got, w)
+		}
+		days := 365
+		if w.year == 2024 {
+			days = 366
+		}
+		if got.Workdays+got.RestDays != days {
+			t.Errorf("%d: Workdays + RestDays = %d, want %d", w.year, got.Workdays+got.RestDays, days)
+		}
+	}
+
+	if _, err := checker.TrendStats(2026, 2024); err == nil {
+		t.Error("expected error for reversed range")
+	}
+	var loadErr *LoadYearsError
+	if _, err := checker.TrendStats(2026, 2099); !errors.As(err, &loadErr) {
+		t.Errorf("TrendStats(2026, 2099) error = %v, want *LoadYearsError", err)
+	}
+}
@@ -0,0 +1,136 @@
+package cnholiday
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// SVG 月历布局尺寸
+const (
+	svgCellWidth   = 40
+	svgCellHeight  = 40
+	svgTitleHeight = 32
+	svgHeadHeight  = 24
+	svgPadding     = 12
+	svgMonthWidth  = 7 * svgCellWidth
+	svgMonthHeight = svgTitleHeight + svgHeadHeight + 6*svgCellHeight
+
+	svgYearColumns     = 4 // 全年视图每行的月份数
+	svgYearTitleHeight = 48
+)
+
+// SVG 配色
+const (
+	svgHolidayColor   = "#d93025"
+	svgHolidayFill    = "#fdecea"
+	svgAdjustedColor  = "#1a73e8"
+	svgAdjustedFill   = "#e8f0fe"
+	svgDefaultColor   = "#202124"
+	svgSecondaryColor = "#5f6368"
+	svgFontFamily     = "PingFang SC, Microsoft YaHei, Noto Sans CJK SC, sans-serif"
+)
+
+// RenderMonthSVG 将指定月份渲染为 SVG 图片：节假日红色并标注"休"，调休工作日标注"班"，
+// 每段假期的第一天下方显示节日名称
+func (c *Checker) RenderMonthSVG(w io.Writer, year int, month time.Month) error {
+	var body bytes.Buffer
+	if err := c.writeMonthSVG(&body, year, month, 0, 0); err != nil {
+		return err
+	}
+	return writeSVG(w, svgMonthWidth+2*svgPadding, svgMonthHeight+2*svgPadding, body.Bytes())
+}
+
+// RenderYearSVG 将全年 12 个月渲染为一张 SVG 图片，每行 4 个月
+func (c *Checker) RenderYearSVG(w io.Writer, year int) error {
+	var body bytes.Buffer
+	fmt.Fprintf(&body, `<text x="%d" y="%d" font-size="28" font-weight="bold" text-anchor="middle" fill="%s">%d年</text>`+"\n",
+		(svgYearColumns*(svgMonthWidth+svgPadding)+svgPadding)/2, svgYearTitleHeight-12, svgDefaultColor, year)
+	for m := time.January; m <= time.December; m++ {
+		i := int(m) - 1
+		x := (i % svgYearColumns) * (svgMonthWidth + svgPadding)
+		y := svgYearTitleHeight + (i/svgYearColumns)*(svgMonthHeight+svgPadding) - svgPadding
+		if err := c.writeMonthSVG(&body, year, m, x, y); err != nil {
+			return err
+		}
+	}
+	rows := 12 / svgYearColumns
+	width := svgYearColumns*(svgMonthWidth+svgPadding) + svgPadding
+	height := svgYearTitleHeight + rows*(svgMonthHeight+svgPadding)
+	return writeSVG(w, width, height, body.Bytes())
+}
+
+// writeSVG 输出 SVG 文档，内容相对 (svgPadding, svgPadding) 偏移
+func writeSVG(w io.Writer, width, height int, body []byte) error {
+	_, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="%s">
+<rect width="100%%" height="100%%" fill="#ffffff"/>
+<g transform="translate(%d,%d)">
+%s</g>
+</svg>
+`, width, height, width, height, svgFontFamily, svgPadding, svgPadding, body)
+	return err
+}
+
+// writeMonthSVG 在 (x, y) 处绘制一个月的月历
+func (c *Checker) writeMonthSVG(b *bytes.Buffer, year int, month time.Month, x, y int) error {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	fmt.Fprintf(b, `<g transform="translate(%d,%d)">`+"\n", x, y)
+	fmt.Fprintf(b, `<text x="%d" y="22" font-size="18" font-weight="bold" text-anchor="middle" fill="%s">%d年%d月</text>`+"\n",
+		svgMonthWidth/2, svgDefaultColor, year, month)
+	for i, wd := range chineseWeekdays {
+		color := svgSecondaryColor
+		if i >= 5 {
+			color = svgHolidayColor
+		}
+		fmt.Fprintf(b, `<text x="%d" y="%d" font-size="13" text-anchor="middle" fill="%s">%s</text>`+"\n",
+			i*svgCellWidth+svgCellWidth/2, svgTitleHeight+16, color, wd)
+	}
+
+	offset := (int(first.Weekday()) + 6) % 7
+	prevName := ""
+	for d := first; d.Month() == month; d = d.AddDate(0, 0, 1) {
+		info, err := c.GetHolidayInfo(d)
+		if err != nil {
+			return err
+		}
+		pos := offset + d.Day() - 1
+		cx := (pos % 7) * svgCellWidth
+		cy := svgTitleHeight + svgHeadHeight + (pos/7)*svgCellHeight
+
+		color, fill, mark := svgDefaultColor, "", ""
+		switch info.DayType() {
+		case DayTypePublicHoliday, DayTypeInLieu:
+			color, fill, mark = svgHolidayColor, svgHolidayFill, "休"
+		case DayTypeAdjustedWorkday:
+			color, fill, mark = svgAdjustedColor, svgAdjustedFill, "班"
+		case DayTypeWeekend:
+			color = svgHolidayColor
+		}
+		if fill != "" {
+			fmt.Fprintf(b, `<rect x="%d" y="%d" width="%d" height="%d" rx="4" fill="%s"/>`+"\n",
+				cx+1, cy+1, svgCellWidth-2, svgCellHeight-2, fill)
+		}
+		fmt.Fprintf(b, `<text x="%d" y="%d" font-size="15" text-anchor="middle" fill="%s">%d</text>`+"\n",
+			cx+svgCellWidth/2, cy+20, color, d.Day())
+		if mark != "" {
+			fmt.Fprintf(b, `<text x="%d" y="%d" font-size="9" text-anchor="end" fill="%s">%s</text>`+"\n",
+				cx+svgCellWidth-3, cy+11, color, mark)
+		}
+
+		name := ""
+		if info.HolidayName != "" && !info.IsAdjustedWorkday {
+			name = chineseName(info.HolidayName)
+		}
+		if name != "" && name != prevName {
+			fmt.Fprintf(b, `<text x="%d" y="%d" font-size="9" text-anchor="middle" fill="%s">`,
+				cx+svgCellWidth/2, cy+34, color)
+			xml.EscapeText(b, []byte(name))
+			b.WriteString("</text>\n")
+		}
+		prevName = name
+	}
+	b.WriteString("</g>\n")
+	return nil
+}
@@ -0,0 +1,61 @@
+package cnholiday
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+// checkWellFormed 确认输出是格式正确的 XML
+func checkWellFormed(t *testing.T, doc string) {
+	t.Helper()
+	dec := xml.NewDecoder(strings.NewReader(doc))
+	for {
+		if _, err := dec.Token(); err == io.EOF {
+			return
+		} else if err != nil {
+			t.Fatalf("invalid SVG: %v", err)
+		}
+	}
+}
+
+func TestRenderMonthSVG(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var b strings.Builder
+	if err := checker.RenderMonthSVG(&b, 2026, time.October); err != nil {
+		t.Fatalf("RenderMonthSVG failed: %v", err)
+	}
+	out := b.String()
+	checkWellFormed(t, out)
+
+	for _, want := range []string{"<svg ", "2026年10月", ">国庆节</text>", ">班</text>", ">休</text>"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("SVG missing %q", want)
+		}
+	}
+	if n := strings.Count(out, ">国庆节</text>"); n != 1 {
+		t.Errorf("holiday name rendered %d times, want once per span", n)
+	}
+}
+
+func TestRenderYearSVG(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+
+	var b strings.Builder
+	if err := checker.RenderYearSVG(&b, 2026); err != nil {
+		t.Fatalf("RenderYearSVG failed: %v", err)
+	}
+	out := b.String()
+	checkWellFormed(t, out)
+
+	if n := strings.Count(out, "月</text>"); n != 12 {
+		t.Errorf("rendered %d months, want 12", n)
+	}
+
+	if err := checker.RenderYearSVG(&b, 1990); err == nil {
+		t.Error("expected error for year without data")
+	}
+}
@@ -0,0 +1,180 @@
+package cnholiday
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Override 单日覆盖规则，用于在国家法定数据之上定义公司自己的安排
+type Override struct {
+	IsHoliday bool    // true 表示当天休息，false 表示当天上班
+	Name      string  // 覆盖原因，例如"公司年会"
+	Type      DayType // 可选的自定义日期类型，由 Custom 创建，例如 Custom("盘点日")
+}
+
+// TenantManager 多租户日历管理器
+// 所有租户共享同一个基础检查器中的国家数据，每个租户只额外保存自己的覆盖规则。
+// 覆盖规则采用写时复制：修改时复制该租户的规则表并整体替换，查询无需加锁等待写入
+type TenantManager struct {
+	base    *Checker
+	mu      sync.RWMutex
+	tenants map[string]map[int]Override // 租户 ID -> 日期(dayNumber) -> 覆盖规则
+}
+
+// NewTenantManager 基于共享的检查器创建多租户管理器
+func NewTenantManager(base *Checker) *TenantManager {
+	return &TenantManager{
+		base:    base,
+		tenants: make(map[string]map[int]Override),
+	}
+}
+
+// SetOverride 为租户设置某一天的覆盖规则
+// 每次调用都会复制该租户的规则表，批量导入请使用 SetOverrides
+func (m *TenantManager) SetOverride(tenantID string, date time.Time, override Override) {
+	m.SetOverrides(tenantID, map[time.Time]Override{date: override})
+}
+
+// SetOverrides 为租户批量设置覆盖规则，整批只复制一次规则表
+// 多个键落在同一天时，保留哪一条不确定
+func (m *TenantManager) SetOverrides(tenantID string, overrides map[time.Time]Override) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current := m.tenants[tenantID]
+	next := make(map[int]Override, len(current)+len(overrides))
+	for k, v := range current {
+		next[k] = v
+	}
+	for date, override := range overrides {
+		next[dayNumber(date)] = override
+	}
+	m.tenants[tenantID] = next
+}
+
+// RemoveOverride 删除租户某一天的覆盖规则
+func (m *TenantManager) RemoveOverride(tenantID string, date time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.tenants[tenantID]
+	if !ok {
+		return
+	}
+	key := dayNumber(date)
+	if _, exists := current[key]; !exists {
+		return
+	}
+	next := make(map[int]Override, len(current))
+	for k, v := range current {
+		if k != key {
+			next[k] = v
+		}
+	}
+	m.tenants[tenantID] = next
+}
+
+// RemoveTenant 删除租户及其全部覆盖规则
+func (m *TenantManager) RemoveTenant(tenantID string) {
+	m.mu.Lock()
+	delete(m.tenants, tenantID)
+	m.mu.Unlock()
+}
+
+// Tenants 返回设置过覆盖规则的租户 ID，按字典序排列
+func (m *TenantManager) Tenants() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := make([]string, 0, len(m.tenants))
+	for id := range m.tenants {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ForTenant 返回租户日历
+// 返回值是调用时刻的快照，之后对该租户覆盖规则的修改不会影响已返回的日历
+func (m *TenantManager) ForTenant(tenantID string) *TenantCalendar {
+	m.mu.RLock()
+	overrides := m.tenants[tenantID]
+	m.mu.RUnlock()
+
+	return &TenantCalendar{
+		ID:        tenantID,
+		base:      m.base,
+		overrides: overrides,
+	}
+}
+
+// TenantCalendar 租户日历，先查租户覆盖规则，未覆盖的日期使用国家数据
+type TenantCalendar struct {
+	ID        string
+	base      *Checker
+	overrides map[int]Override // 只读快照，按 dayNumber 索引
+}
+
+// override 查询指定日期的覆盖规则
+func (t *TenantCalendar) override(date time.Time) (Override, bool) {
+	if len(t.overrides) == 0 {
+		return Override{}, false
+	}
+	o, ok := t.overrides[dayNumber(date)]
+	return o, ok
+}
+
+// IsHoliday 判断指定日期对该租户是否是休息日
+func (t *TenantCalendar) IsHoliday(date time.Time) (bool, string, error) {
+	if o, ok := t.override(date); ok {
+		return o.IsHoliday, o.Name, nil
+	}
+	return t.base.IsHoliday(date)
+}
+
+// IsWorkday 判断指定日期对该租户是否是工作日
+func (t *TenantCalendar) IsWorkday(date time.Time) (bool, error) {
+	isHoliday, _, err := t.IsHoliday(date)
+	if err != nil {
+		return false, err
+	}
+	return !isHoliday, nil
+}
+
+// GetHolidayInfo 获取指定日期对该租户的详细信息
+func (t *TenantCalendar) GetHolidayInfo(date time.Time) (*HolidayInfo, error) {
+	o, ok := t.override(date)
+	if !ok {
+		return t.base.GetHolidayInfo(date)
+	}
+	info := &HolidayInfo{
+		Date:        date,
+		Weekday:     date.Weekday(),
+		IsWorkday:   !o.IsHoliday,
+		IsHoliday:   o.IsHoliday,
+		HolidayName: o.Name,
+		Source:      SourceOverride,
+	}
+	if o.Type.IsCustom() {
+		info.CustomType = o.Type
+	}
+	return info, nil
+}
+
+// Classify 返回指定日期对该租户的类型及名称
+// 覆盖规则指定了自定义类型时返回该类型，否则按休息或上班返回节假日或工作日
+func (t *TenantCalendar) Classify(date time.Time) (DayType, string, error) {
+	if _, ok := t.override(date); !ok {
+		return t.base.Classify(date)
+	}
+	info, err := t.GetHolidayInfo(date)
+	if err != nil {
+		return DayTypeWorkday, "", err
+	}
+	return info.DayType(), info.HolidayName, nil
+}
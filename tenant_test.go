@@ -0,0 +1,148 @@
+package cnholiday
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTenantTestManager(t *testing.T) *TenantManager {
+	t.Helper()
+	base := NewCheckerWithConfig(Config{DisableRemote: true})
+	jsonData := []byte(`{
+		"holidays": {"2026-01-01": "元旦"},
+		"workdays": {"2026-01-04": "元旦"},
+		"inLieuDays": {}
+	}`)
+	if err := base.LoadYearFromJSON(2026, jsonData); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+	return NewTenantManager(base)
+}
+
+func TestTenantOverrides(t *testing.T) {
+	m := newTenantTestManager(t)
+
+	annual := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)    // 周一
+	saturday := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC) // 周六
+	m.SetOverride("acme", annual, Override{IsHoliday: true, Name: "公司年会"})
+	m.SetOverride("acme", saturday, Override{Name: "盘点"})
+
+	tests := []struct {
+		tenant   string
+		date     time.Time
+		expected bool
+	}{
+		{"acme", annual, false},
+		{"acme", saturday, true},
+		{"acme", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"other", annual, true},
+		{"other", saturday, false},
+	}
+	for _, tt := range tests {
+		isWorkday, err := m.ForTenant(tt.tenant).IsWorkday(tt.date)
+		if err != nil {
+			t.Fatalf("IsWorkday failed: %v", err)
+		}
+		if isWorkday != tt.expected {
+			t.Errorf("%s IsWorkday(%s) = %v, want %v", tt.tenant, tt.date.Format("2006-01-02"), isWorkday, tt.expected)
+		}
+	}
+
+	info, err := m.ForTenant("acme").GetHolidayInfo(annual)
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if !info.IsHoliday || info.HolidayName != "公司年会" || info.Source != SourceOverride {
+		t.Errorf("unexpected info: %+v", info)
+	}
+}
+
+func TestTenantCopyOnWrite(t *testing.T) {
+	m := newTenantTestManager(t)
+	date := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	m.SetOverride("acme", date, Override{IsHoliday: true, Name: "公司年会"})
+	snapshot := m.ForTenant("acme")
+
+	m.RemoveOverride("acme", date)
+
+	isHoliday, _, _ := snapshot.IsHoliday(date)
+	if !isHoliday {
+		t.Error("snapshot should not be affected by later changes")
+	}
+	isHoliday, _, _ = m.ForTenant("acme").IsHoliday(date)
+	if isHoliday {
+		t.Error("override should be removed for new calendars")
+	}
+
+	m.SetOverride("beta", date, Override{IsHoliday: true})
+	if got := m.Tenants(); !reflect.DeepEqual(got, []string{"acme", "beta"}) {
+		t.Errorf("Tenants() = %v", got)
+	}
+	m.RemoveTenant("acme")
+	if got := m.Tenants(); !reflect.DeepEqual(got, []string{"beta"}) {
+		t.Errorf("Tenants() after remove = %v", got)
+	}
+}
+
+func TestTenantSetOverrides(t *testing.T) {
+	m := newTenantTestManager(t)
+	annual := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	m.SetOverride("acme", annual, Override{IsHoliday: true, Name: "公司年会"})
+	snapshot := m.ForTenant("acme")
+
+	overrides := make(map[time.Time]Override)
+	for day := 10; day <= 31; day++ {
+		overrides[time.Date(2026, 1, day, 0, 0, 0, 0, time.UTC)] = Override{IsHoliday: true, Name: "停工"}
+	}
+	m.SetOverrides("acme", overrides)
+	m.SetOverrides("empty", nil)
+
+	tenant := m.ForTenant("acme")
+	if got := len(tenant.overrides); got != 23 {
+		t.Errorf("overrides = %d, want 23", got)
+	}
+	for _, date := range []time.Time{annual, time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)} {
+		if isHoliday, _, _ := tenant.IsHoliday(date); !isHoliday {
+			t.Errorf("%s should be a holiday", date.Format("2006-01-02"))
+		}
+	}
+	if got := len(snapshot.overrides); got != 1 {
+		t.Errorf("snapshot overrides = %d, want 1", got)
+	}
+	if got := m.Tenants(); !reflect.DeepEqual(got, []string{"acme"}) {
+		t.Errorf("Tenants() = %v, want [acme]", got)
+	}
+}
+
+func TestTenantCustomDayType(t *testing.T) {
+	m := newTenantTestManager(t)
+	stocktake, err := Custom("盘点日")
+	if err != nil {
+		t.Fatalf("Custom failed: %v", err)
+	}
+	saturday := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	m.SetOverride("acme", saturday, Override{Name: "年度盘点", Type: stocktake})
+
+	cal := m.ForTenant("acme")
+	info, err := cal.GetHolidayInfo(saturday)
+	if err != nil {
+		t.Fatalf("GetHolidayInfo failed: %v", err)
+	}
+	if !info.IsWorkday || info.CustomType != stocktake || info.DayType() != stocktake {
+		t.Errorf("unexpected info: %+v", info)
+	}
+
+	dayType, name, err := cal.Classify(saturday)
+	if err != nil || dayType != stocktake || name != "年度盘点" || dayType.String() != "盘点日" {
+		t.Errorf("Classify = %v, %q, %v", dayType, name, err)
+	}
+	// 未指定类型的覆盖规则按休息或上班分类
+	annual := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	m.SetOverride("acme", annual, Override{IsHoliday: true, Name: "公司年会"})
+	if dayType, _, _ := m.ForTenant("acme").Classify(annual); dayType != DayTypePublicHoliday {
+		t.Errorf("Classify(annual) = %v, want %v", dayType, DayTypePublicHoliday)
+	}
+}
@@ -0,0 +1,67 @@
+package cnholiday
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// newHTTPClient 根据配置创建远程请求使用的 HTTP 客户端
+// 未配置 ProxyURL 时沿用 HTTP(S)_PROXY 等环境变量
+func newHTTPClient(config Config) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxy, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("代理地址无效: %w", err)
+		}
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("代理地址无效: %s", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if config.TLSConfig != nil {
+		transport.TLSClientConfig = config.TLSConfig.Clone()
+	}
+	if config.RootCAs != nil {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = config.RootCAs
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   config.RemoteTimeout,
+	}, nil
+}
+
+// RemoteAuth 远程数据源的认证方式，BearerToken 与 Username/Password 只能设置一种，Sign 可以与二者同时使用
+type RemoteAuth struct {
+	BearerToken string // 设置 Authorization: Bearer 请求头
+	Username    string // 基本认证的用户名
+	Password    string // 基本认证的密码
+	// Sign 在发送前修改请求，例如添加签名头，或将地址替换为对象存储的预签名地址
+	// 返回错误时放弃本次请求
+	Sign func(req *http.Request) error
+}
+
+// apply 为请求添加认证信息，auth 为 nil 时不做处理
+func (auth *RemoteAuth) apply(req *http.Request) error {
+	if auth == nil {
+		return nil
+	}
+	switch {
+	case auth.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+auth.BearerToken)
+	case auth.Username != "" || auth.Password != "":
+		req.SetBasicAuth(auth.Username, auth.Password)
+	}
+	if auth.Sign != nil {
+		return auth.Sign(req)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package cnholiday
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+const emptyYearJSON = `{"holidays":{},"workdays":{},"inLieuDays":{}}`
+
+func TestRemoteCustomRootCAs(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(emptyYearJSON))
+	}))
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	defer server.Close()
+
+	// 未信任服务端证书时请求失败
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL})
+	if _, err := checker.loadYearFromRemote(context.Background(), 2026); err == nil {
+		t.Fatal("expected certificate error without custom root CAs")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	checker = NewCheckerWithConfig(Config{
+		CDNBaseURL: server.URL,
+		RootCAs:    pool,
+		TLSConfig:  &tls.Config{MinVersion: tls.VersionTLS12},
+	})
+	if _, err := checker.loadYearFromRemote(context.Background(), 2026); err != nil {
+		t.Fatalf("remote load with custom root CAs failed: %v", err)
+	}
+}
+
+func TestRemoteProxyURL(t *testing.T) {
+	var proxied int32
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&proxied, 1)
+		if r.URL.Host != "holidays.invalid" {
+			t.Errorf("proxy received host %q", r.URL.Host)
+		}
+		w.Write([]byte(emptyYearJSON))
+	}))
+	defer proxy.Close()
+
+	checker := NewCheckerWithConfig(Config{
+		CDNBaseURL: "http://holidays.invalid/years",
+		ProxyURL:   proxy.URL,
+	})
+	if _, err := checker.loadYearFromRemote(context.Background(), 2026); err != nil {
+		t.Fatalf("remote load through proxy failed: %v", err)
+	}
+	if atomic.LoadInt32(&proxied) != 1 {
+		t.Error("request did not go through the configured proxy")
+	}
+}
+
+func TestInvalidProxyURL(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{
+		ProxyURL: "://bad",
+	})
+	if _, err := checker.loadYearFromRemote(context.Background(), 2026); err == nil {
+		t.Fatal("expected error for invalid proxy URL")
+	}
+
+	// 远程配置错误时仍可回退到内置数据
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("LoadYear should fall back to embedded data: %v", err)
+	}
+}
+
+func TestRemoteAuth(t *testing.T) {
+	var gotAuth, gotQuery atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth.Store(r.Header.Get("Authorization"))
+		gotQuery.Store(r.URL.RawQuery)
+		w.Write([]byte(emptyYearJSON))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		auth      *RemoteAuth
+		wantAuth  string
+		wantQuery string
+	}{
+		{"none", nil, "", ""},
+		{"bearer", &RemoteAuth{BearerToken: "secret"}, "Bearer secret", ""},
+		{"basic", &RemoteAuth{Username: "user", Password: "pass"}, "Basic dXNlcjpwYXNz", ""},
+		{"presigned", &RemoteAuth{Sign: func(req *http.Request) error {
+			req.URL.RawQuery = "X-Signature=abc"
+			return nil
+		}}, "", "X-Signature=abc"},
+	}
+	for _, tt := range tests {
+		checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL, RemoteAuth: tt.auth})
+		if _, err := checker.loadYearFromRemote(context.Background(), 2026); err != nil {
+			t.Fatalf("%s: remote load failed: %v", tt.name, err)
+		}
+		if got := gotAuth.Load(); got != tt.wantAuth {
+			t.Errorf("%s: Authorization = %q, want %q", tt.name, got, tt.wantAuth)
+		}
+		if got := gotQuery.Load(); got != tt.wantQuery {
+			t.Errorf("%s: query = %q, want %q", tt.name, got, tt.wantQuery)
+		}
+	}
+
+	// 签名失败时不发送请求
+	checker := NewCheckerWithConfig(Config{CDNBaseURL: server.URL, RemoteAuth: &RemoteAuth{
+		Sign: func(*http.Request) error { return errors.New("凭证已过期") },
+	}})
+	if _, err := checker.loadYearFromRemote(context.Background(), 2026); err == nil {
+		t.Error("expected error when signing fails")
+	}
+}
@@ -0,0 +1,115 @@
+package cnholiday
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/luojiego/cnholiday"
+)
+
+// Calendar 节假日查询接口，应用代码依赖该接口，便于在测试中替换或叠加缓存、监控等装饰器
+type Calendar interface {
+	DayType(ctx context.Context, date time.Time) (DayType, error)
+	Info(ctx context.Context, date time.Time) (*HolidayInfo, error)
+	IsHoliday(ctx context.Context, date time.Time) (bool, error)
+	IsWorkday(ctx context.Context, date time.Time) (bool, error)
+	CountWorkdays(ctx context.Context, r DateRange) (int, error)
+	AddWorkdays(ctx context.Context, date time.Time, n int) (time.Time, error)
+}
+
+var _ Calendar = (*Checker)(nil)
+
+// Checker 节假日检查器，可以在多个 goroutine 中并发使用
+type Checker struct {
+	v1        *v1.Checker
+	heuristic bool // Config.HeuristicFallback，数据加载失败时交由 v1 按周末推断
+}
+
+// New 校验配置并创建检查器，配置有误时返回 *ConfigError
+func New(config Config) (*Checker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Checker{v1: v1.NewCheckerWithConfig(config), heuristic: config.HeuristicFallback}, nil
+}
+
+// V1 返回底层的 v1 检查器，与本检查器共享缓存和配置，供尚未迁移到 v2 的代码使用
+func (c *Checker) V1() *v1.Checker {
+	return c.v1
+}
+
+// Close 停止检查器的全部后台任务，参见 v1 的 Checker.Close
+func (c *Checker) Close() error {
+	return c.v1.Close()
+}
+
+// Subscribe 订阅数据变更，返回取消订阅的函数
+func (c *Checker) Subscribe(fn func(ChangeEvent)) (cancel func()) {
+	return c.v1.Subscribe(fn)
+}
+
+// DayType 返回日期类型
+func (c *Checker) DayType(ctx context.Context, date time.Time) (DayType, error) {
+	if err := c.load(ctx, date.Year()); err != nil {
+		return 0, err
+	}
+	dayType, _, err := c.v1.Classify(date)
+	return dayType, err
+}
+
+// Info 返回日期的详细信息
+func (c *Checker) Info(ctx context.Context, date time.Time) (*HolidayInfo, error) {
+	if err := c.load(ctx, date.Year()); err != nil {
+		return nil, err
+	}
+	return c.v1.GetHolidayInfo(date)
+}
+
+// IsHoliday 判断是否是休息日(节假日、补休日或不需要调休上班的周末)，节日名称通过 Info 获取
+func (c *Checker) IsHoliday(ctx context.Context, date time.Time) (bool, error) {
+	if err := c.load(ctx, date.Year()); err != nil {
+		return false, err
+	}
+	isHoliday, _, err := c.v1.IsHoliday(date)
+	return isHoliday, err
+}
+
+// IsWorkday 判断是否是工作日(含调休工作日)
+func (c *Checker) IsWorkday(ctx context.Context, date time.Time) (bool, error) {
+	if err := c.load(ctx, date.Year()); err != nil {
+		return false, err
+	}
+	return c.v1.IsWorkday(date)
+}
+
+// CountWorkdays 统计区间内的工作日天数，结束日期早于开始日期时返回 ErrInvalidRange
+// 区间内缺少数据的年份在 ctx 的控制下加载
+func (c *Checker) CountWorkdays(ctx context.Context, r DateRange) (int, error) {
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+	if err := c.load(ctx, r.Years()...); err != nil {
+		return 0, err
+	}
+	return c.v1.CountWorkdaysIn(r)
+}
+
+// AddWorkdays 返回 date 之后第 n 个工作日(不含当天)，n 为负数时向前查找，n 为 0 时返回 date
+// 查找经过的年份在 ctx 的控制下逐年加载
+func (c *Checker) AddWorkdays(ctx context.Context, date time.Time, n int) (time.Time, error) {
+	return v1.StepWorkdays(func(day time.Time) (bool, error) {
+		return c.IsWorkday(ctx, day)
+	}, date, n)
+}
+
+// load 在 ctx 的控制下加载尚未缓存的年份，ctx 取消不影响其他调用方共享的加载，参见 v1 的 EnsureYearLoaded
+// 开启 HeuristicFallback 时忽略加载失败(ctx 取消除外)，由 v1 按周末推断作答
+func (c *Checker) load(ctx context.Context, years ...int) error {
+	for _, year := range years {
+		err := c.v1.EnsureYearLoaded(ctx, year)
+		if err != nil && (!c.heuristic || ctx.Err() != nil) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package cnholiday
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestChecker(t *testing.T, config Config) *Checker {
+	t.Helper()
+	config.DisableRemote = true
+	checker, err := New(config)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	t.Cleanup(func() { checker.Close() })
+	return checker
+}
+
+func TestNewValidatesConfig(t *testing.T) {
+	var configErr *ConfigError
+	if _, err := New(Config{CDNBaseURL: "ftp://example.com"}); !errors.As(err, &configErr) {
+		t.Errorf("New error = %v, want *ConfigError", err)
+	}
+}
+
+func TestQueries(t *testing.T) {
+	ctx := context.Background()
+	checker := newTestChecker(t, Config{})
+
+	tests := []struct {
+		date      time.Time
+		dayType   DayType
+		isHoliday bool
+		isWorkday bool
+	}{
+		{time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local), DayTypePublicHoliday, true, false},
+		{time.Date(2026, 10, 7, 0, 0, 0, 0, time.Local), DayTypeInLieu, true, false},
+		{time.Date(2026, 10, 10, 0, 0, 0, 0, time.Local), DayTypeAdjustedWorkday, false, true},
+		{time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local), DayTypeWorkday, false, true},
+		{time.Date(2026, 10, 17, 0, 0, 0, 0, time.Local), DayTypeWeekend, true, false},
+	}
+	for _, tt := range tests {
+		if got, err := checker.DayType(ctx, tt.date); err != nil || got != tt.dayType {
+			t.Errorf("DayType(%s) = %v, %v, want %v", tt.date.Format("2006-01-02"), got, err, tt.dayType)
+		}
+		if got, err := checker.IsHoliday(ctx, tt.date); err != nil || got != tt.isHoliday {
+			t.Errorf("IsHoliday(%s) = %v, %v, want %v", tt.date.Format("2006-01-02"), got, err, tt.isHoliday)
+		}
+		if got, err := checker.IsWorkday(ctx, tt.date); err != nil || got != tt.isWorkday {
+			t.Errorf("IsWorkday(%s) = %v, %v, want %v", tt.date.Format("2006-01-02"), got, err, tt.isWorkday)
+		}
+	}
+
+	info, err := checker.Info(ctx, tests[0].date)
+	if err != nil || info.HolidayName == "" {
+		t.Errorf("Info = %+v, %v", info, err)
+	}
+	// 与 v1 共享缓存
+	if !checker.V1().IsYearLoaded(2026) {
+		t.Error("V1 should share the cache")
+	}
+}
+
+func TestRangeQueries(t *testing.T) {
+	ctx := context.Background()
+	checker := newTestChecker(t, Config{})
+	start := time.Date(2025, 12, 1, 0, 0, 0, 0, time.Local)
+	end := time.Date(2026, 1, 31, 0, 0, 0, 0, time.Local)
+
+	got, err := checker.CountWorkdays(ctx, DateRange{Start: start, End: end})
+	if err != nil {
+		t.Fatalf("CountWorkdays failed: %v", err)
+	}
+	want, _ := checker.V1().CountWorkdaysBetween(start, end)
+	if got != want {
+		t.Errorf("CountWorkdays = %d, want %d", got, want)
+	}
+	if _, err := checker.CountWorkdays(ctx, DateRange{Start: end, End: start}); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("CountWorkdays(reversed) error = %v, want ErrInvalidRange", err)
+	}
+
+	for _, n := range []int{-10, 0, 1, 25} {
+		got, err := checker.AddWorkdays(ctx, start, n)
+		if err != nil {
+			t.Fatalf("AddWorkdays(%d) failed: %v", n, err)
+		}
+		want, _ := checker.V1().AddWorkdays(start, n)
+		if !got.Equal(want) {
+			t.Errorf("AddWorkdays(%d) = %s, want %s", n, got.Format("2006-01-02"), want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	date := time.Date(2099, 6, 1, 0, 0, 0, 0, time.Local)
+	checker := newTestChecker(t, Config{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := checker.IsWorkday(ctx, date); !errors.Is(err, context.Canceled) {
+		t.Errorf("IsWorkday(canceled) error = %v, want context.Canceled", err)
+	}
+
+	var loadErr *YearLoadError
+	if _, err := checker.IsWorkday(context.Background(), date); !errors.As(err, &loadErr) || loadErr.Year != 2099 {
+		t.Errorf("IsWorkday(2099) error = %v, want *YearLoadError", err)
+	}
+
+	// 开启 HeuristicFallback 时按周末推断作答
+	heuristic := newTestChecker(t, Config{HeuristicFallback: true})
+	info, err := heuristic.Info(context.Background(), date)
+	if err != nil || !info.Heuristic || !info.IsWorkday {
+		t.Errorf("Info(2099) = %+v, %v, want heuristic workday", info, err)
+	}
+}
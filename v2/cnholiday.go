@@ -0,0 +1,49 @@
+package cnholiday
+
+import v1 "github.com/luojiego/cnholiday"
+
+// v2 与 v1 共用以下类型，v1 的值可以直接传给 v2，反之亦然
+type (
+	Config      = v1.Config
+	HolidayData = v1.HolidayData
+	HolidayInfo = v1.HolidayInfo
+	DayType     = v1.DayType
+	Source      = v1.Source
+	ChangeEvent = v1.ChangeEvent
+	DateRange   = v1.DateRange // 以天为单位的区间，Bounds 为零值时两端都包含
+	Bounds      = v1.Bounds
+)
+
+// 区间边界
+const (
+	BoundsClosed     = v1.BoundsClosed     // [Start, End]，默认
+	BoundsClosedOpen = v1.BoundsClosedOpen // [Start, End)
+	BoundsOpenClosed = v1.BoundsOpenClosed // (Start, End]
+	BoundsOpen       = v1.BoundsOpen       // (Start, End)
+)
+
+// 日期类型
+const (
+	DayTypeWorkday         = v1.DayTypeWorkday
+	DayTypeWeekend         = v1.DayTypeWeekend
+	DayTypePublicHoliday   = v1.DayTypePublicHoliday
+	DayTypeAdjustedWorkday = v1.DayTypeAdjustedWorkday
+	DayTypeInLieu          = v1.DayTypeInLieu
+)
+
+// 错误类型，用 errors.As 判断
+type (
+	ConfigError        = v1.ConfigError        // New 的配置有误
+	YearLoadError      = v1.YearLoadError      // 年份数据加载失败，包含每个数据源的错误
+	YearRangeError     = v1.YearRangeError     // 年份超出 Config.MinYear ~ Config.MaxYear
+	QuarantineError    = v1.QuarantineError    // 数据未通过解析或校验
+	SchemaVersionError = v1.SchemaVersionError // 数据格式版本高于本库支持的版本
+)
+
+// 哨兵错误，用 errors.Is 判断
+var (
+	ErrCircuitOpen = v1.ErrCircuitOpen
+	ErrRateLimited = v1.ErrRateLimited
+	// ErrInvalidRange 区间的结束日期早于开始日期
+	ErrInvalidRange = v1.ErrInvalidRange
+)
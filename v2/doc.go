@@ -0,0 +1,29 @@
+// Package cnholiday 是 github.com/luojiego/cnholiday 的 v2 API：面向接口、所有查询都接受 context.Context、
+// 错误可以用 errors.Is/errors.As 区分。
+//
+//	checker, err := cnholiday.New(cnholiday.Config{DisableRemote: true})
+//	if err != nil {
+//		return err // *ConfigError，配置有误时不再静默使用默认值
+//	}
+//	defer checker.Close()
+//	ok, err := checker.IsWorkday(ctx, time.Now())
+//
+// # 迁移计划
+//
+// v1 和 v2 可以同时依赖，迁移按模块逐步进行，不需要一次性切换：
+//
+//  1. v2.0：v2 是 v1 引擎之上的一层薄封装，类型、错误与 v1 相同(类型别名)，
+//     Checker.V1 返回共享缓存的 v1 检查器，尚未迁移的代码继续使用 v1 API。
+//     v2 的 go.mod 要求已发布的 v1(v1.0.0 起)，发布时先打 v1 的标签，再打 v2 的标签。
+//  2. 实现移入 v2、v1 的函数和方法改为调用 v2 的薄封装，不在 v2.0 的范围内，之后作为单独的版本进行，
+//     届时 v1 的行为和签名保持不变。
+//  3. v1 只接收修复，新功能只加入 v2。
+//
+// v1 到 v2 的主要变化：
+//
+//   - 查询方法的第一个参数为 ctx，缺少数据的年份在 ctx 的控制下加载，取消后立即返回
+//   - New 校验配置并返回错误，v1 的 NewCheckerWithConfig 不校验
+//   - IsHoliday 不再返回节日名称，名称通过 Info 获取；Classify 更名为 DayType
+//   - 区间参数统一为 DateRange，边界由 DateRange.Bounds 显式指定(默认两端都包含)，不再接受零散的 start、end
+//   - 不提供包级全局函数，检查器显式构造后通过依赖注入传递，应用代码依赖 Calendar 接口
+package cnholiday
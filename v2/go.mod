@@ -0,0 +1,7 @@
+module github.com/luojiego/cnholiday/v2
+
+go 1.25.6
+
+// v2 是 v1 引擎之上的薄封装，要求包含 DateRange 与按 context 加载的 v1 正式版本；
+// 仓库内开发时由根目录的 go.work 使用工作区中的 v1
+require github.com/luojiego/cnholiday v1.0.0
@@ -0,0 +1,131 @@
+package cnholiday
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookSignatureHeader 携带签名的请求头，格式为 "sha256=<十六进制 HMAC-SHA256>"，
+// 签名内容为 WebhookTimestampHeader 的值、"." 与请求体依次拼接
+const WebhookSignatureHeader = "X-Cnholiday-Signature"
+
+// WebhookTimestampHeader 携带发送时间(Unix 秒)的请求头，与请求体一起签名，用于拒绝重放的请求
+const WebhookTimestampHeader = "X-Cnholiday-Timestamp"
+
+// WebhookTolerance VerifyWebhookSignature 允许的发送时间与当前时间的最大偏差
+const WebhookTolerance = 5 * time.Minute
+
+// defaultWebhookTimeout Webhook 请求默认超时时间
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookNotifier 在数据变更时向指定地址发送带签名的 POST 请求，请求体为 ChangeEvent 的 JSON
+// Handle 只将事件放入有界队列，由单独的 goroutine 按接收顺序逐个发送，不阻塞触发变更的加载、修订或轮询；
+// 不再使用时调用 Close 等待队列中的事件发送完
+//
+//	hook := &cnholiday.WebhookNotifier{URL: "https://example.com/hooks/holiday", Secret: secret}
+//	cancel := checker.Subscribe(hook.Handle)
+//	defer hook.Close()
+type WebhookNotifier struct {
+	URL       string
+	Secret    []byte        // 签名密钥，为空时不签名
+	Client    *http.Client  // 为空时使用 http.DefaultClient
+	Timeout   time.Duration // 单次请求超时，默认 10 秒
+	QueueSize int           // Handle 的队列长度，默认 DefaultEventQueueSize
+	// OnError 发送失败时在发送 goroutine 中调用；队列已满或已关闭时以 ErrEventQueueFull、ErrEventQueueClosed
+	// 在 Handle 的调用方 goroutine 中调用，事件被丢弃
+	OnError func(event ChangeEvent, err error)
+
+	queue eventQueue
+}
+
+// Handle 将变更事件放入发送队列后立即返回，可直接传给 Checker.Subscribe
+func (w *WebhookNotifier) Handle(event ChangeEvent) {
+	if err := w.queue.push(w.QueueSize, event, w.notify); err != nil && w.OnError != nil {
+		w.OnError(event, err)
+	}
+}
+
+// Close 停止接收新的事件，并等待队列中已有的事件发送完，可以多次调用
+func (w *WebhookNotifier) Close() {
+	w.queue.close()
+}
+
+// notify 发送队列中的一个事件
+func (w *WebhookNotifier) notify(event ChangeEvent) {
+	if err := w.Notify(context.Background(), event); err != nil && w.OnError != nil {
+		w.OnError(event, err)
+	}
+}
+
+// Notify 将变更事件同步 POST 到 Webhook 地址，非 2xx 响应视为失败
+func (w *WebhookNotifier) Notify(ctx context.Context, event ChangeEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	timeout := w.Timeout
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(w.Secret) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(WebhookTimestampHeader, timestamp)
+		req.Header.Set(WebhookSignatureHeader, signWebhook(w.Secret, timestamp, body))
+	}
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Webhook 请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Webhook 返回错误状态码: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// VerifyWebhookSignature 校验 Webhook 请求的签名，供接收方使用
+// timestamp 与 signature 分别为 WebhookTimestampHeader 与 WebhookSignatureHeader 的值，
+// 发送时间与当前时间相差超过 WebhookTolerance 时视为重放，返回 false
+func VerifyWebhookSignature(secret, body []byte, timestamp, signature string) bool {
+	timestamp = strings.TrimSpace(timestamp)
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if skew := time.Since(time.Unix(sec, 0)); skew > WebhookTolerance || skew < -WebhookTolerance {
+		return false
+	}
+	return hmac.Equal([]byte(signWebhook(secret, timestamp, body)), []byte(strings.TrimSpace(signature)))
+}
+
+func signWebhook(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
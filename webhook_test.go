@@ -0,0 +1,102 @@
+package cnholiday
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	secret := []byte("s3cret")
+	var received ChangeEvent
+	var verified bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		verified = VerifyWebhookSignature(secret, body, r.Header.Get(WebhookTimestampHeader), r.Header.Get(WebhookSignatureHeader))
+		json.Unmarshal(body, &received)
+	}))
+	defer server.Close()
+
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	var notifyErr error
+	hook := &WebhookNotifier{URL: server.URL, Secret: secret, OnError: func(_ ChangeEvent, err error) { notifyErr = err }}
+	defer checker.Subscribe(hook.Handle)()
+
+	if _, err := checker.PatchYear(2026, &HolidayData{Workdays: map[string]string{"2026-10-10": ""}}); err != nil {
+		t.Fatalf("PatchYear failed: %v", err)
+	}
+	hook.Close()
+	if notifyErr != nil {
+		t.Fatalf("Notify failed: %v", notifyErr)
+	}
+	if !verified {
+		t.Error("signature verification failed")
+	}
+	if received.Year != 2026 || len(received.Changes) != 1 || received.Changes[0].After != DayTypeWeekend {
+		t.Errorf("received = %+v", received)
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	if VerifyWebhookSignature([]byte("other"), []byte("{}"), now, signWebhook(secret, now, []byte("{}"))) {
+		t.Error("signature with wrong secret should not verify")
+	}
+
+	// 签名包含发送时间：改动时间戳或重放过期的请求都无法通过校验
+	earlier := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+	if VerifyWebhookSignature(secret, []byte("{}"), earlier, signWebhook(secret, now, []byte("{}"))) {
+		t.Error("signature with a different timestamp should not verify")
+	}
+	stale := strconv.FormatInt(time.Now().Add(-WebhookTolerance-time.Minute).Unix(), 10)
+	if VerifyWebhookSignature(secret, []byte("{}"), stale, signWebhook(secret, stale, []byte("{}"))) {
+		t.Error("stale timestamp should not verify")
+	}
+	if VerifyWebhookSignature(secret, []byte("{}"), "", signWebhook(secret, "", []byte("{}"))) {
+		t.Error("missing timestamp should not verify")
+	}
+}
+
+func TestWebhookNotifierStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var notifyErr error
+	hook := &WebhookNotifier{URL: server.URL, OnError: func(_ ChangeEvent, err error) { notifyErr = err }}
+	hook.Handle(ChangeEvent{Year: 2026})
+	hook.Close()
+	if notifyErr == nil {
+		t.Error("expected error for 500 response")
+	}
+}
+
+func TestWebhookNotifierAsync(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+
+	// 接收方缓慢时 Handle 不阻塞
+	hook := &WebhookNotifier{URL: server.URL}
+	done := make(chan struct{})
+	go func() {
+		hook.Handle(ChangeEvent{Year: 2026})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("Handle blocked on a slow receiver")
+	}
+	close(release)
+	hook.Close()
+}
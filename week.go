@@ -0,0 +1,62 @@
+package cnholiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkWeek 一个 ISO 周(周一至周日)的工作日情况
+type WorkWeek struct {
+	Year     int       // ISO 周所属年份
+	Week     int       // ISO 周序号
+	Start    time.Time // 周一
+	End      time.Time // 周日
+	Workdays int       // 工作日天数(含调休工作日)
+}
+
+// IsLong 是否是调休导致的长周(工作日多于 5 天)
+func (w *WorkWeek) IsLong() bool {
+	return w.Workdays > 5
+}
+
+// IsShort 是否是节假日导致的短周(工作日少于 5 天)
+func (w *WorkWeek) IsShort() bool {
+	return w.Workdays < 5
+}
+
+// WorkWeek 返回 anchor 所在 ISO 周的工作日情况
+func (c *Checker) WorkWeek(anchor time.Time) (*WorkWeek, error) {
+	offset := (int(anchor.Weekday()) + 6) % 7
+	monday := time.Date(anchor.Year(), anchor.Month(), anchor.Day()-offset, 0, 0, 0, 0, anchor.Location())
+	return c.workWeekFrom(monday)
+}
+
+// ISOWeek 返回 ISO 年份 year 第 week 周的工作日情况
+func (c *Checker) ISOWeek(year, week int) (*WorkWeek, error) {
+	// 1 月 4 日总是位于第 1 周
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, time.Local)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday())+6)%7)+(week-1)*7)
+	if y, w := monday.ISOWeek(); week < 1 || y != year || w != week {
+		return nil, fmt.Errorf("%d 年不存在第 %d 周", year, week)
+	}
+	return c.workWeekFrom(monday)
+}
+
+// WorkdaysInWeek 返回 anchor 所在 ISO 周的工作日天数
+func (c *Checker) WorkdaysInWeek(anchor time.Time) (int, error) {
+	week, err := c.WorkWeek(anchor)
+	if err != nil {
+		return 0, err
+	}
+	return week.Workdays, nil
+}
+
+func (c *Checker) workWeekFrom(monday time.Time) (*WorkWeek, error) {
+	sunday := monday.AddDate(0, 0, 6)
+	workdays, err := c.CountWorkdaysBetween(monday, sunday)
+	if err != nil {
+		return nil, err
+	}
+	year, week := monday.ISOWeek()
+	return &WorkWeek{Year: year, Week: week, Start: monday, End: sunday, Workdays: workdays}, nil
+}
@@ -0,0 +1,68 @@
+package cnholiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkWeek(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	tests := []struct {
+		anchor   time.Time
+		week     int
+		workdays int
+		long     bool
+		short    bool
+	}{
+		{time.Date(2026, 9, 16, 0, 0, 0, 0, time.UTC), 38, 6, true, false},
+		{time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), 40, 3, false, true},
+		{time.Date(2026, 11, 11, 0, 0, 0, 0, time.UTC), 46, 5, false, false},
+	}
+
+	for _, tt := range tests {
+		week, err := checker.WorkWeek(tt.anchor)
+		if err != nil {
+			t.Fatalf("WorkWeek(%s) failed: %v", tt.anchor.Format("2006-01-02"), err)
+		}
+		if week.Week != tt.week || week.Workdays != tt.workdays {
+			t.Errorf("WorkWeek(%s) = week %d, %d workdays; want week %d, %d workdays",
+				tt.anchor.Format("2006-01-02"), week.Week, week.Workdays, tt.week, tt.workdays)
+		}
+		if week.IsLong() != tt.long || week.IsShort() != tt.short {
+			t.Errorf("WorkWeek(%s) IsLong=%v IsShort=%v; want %v %v",
+				tt.anchor.Format("2006-01-02"), week.IsLong(), week.IsShort(), tt.long, tt.short)
+		}
+		if week.Start.Weekday() != time.Monday || week.End.Weekday() != time.Sunday {
+			t.Errorf("WorkWeek(%s) spans %s..%s", tt.anchor.Format("2006-01-02"), week.Start.Weekday(), week.End.Weekday())
+		}
+	}
+}
+
+func TestISOWeek(t *testing.T) {
+	checker := NewCheckerWithConfig(Config{DisableRemote: true})
+	if err := checker.LoadYear(2026); err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+
+	week, err := checker.ISOWeek(2026, 38)
+	if err != nil {
+		t.Fatalf("ISOWeek failed: %v", err)
+	}
+	if got := week.Start.Format("2006-01-02"); got != "2026-09-14" {
+		t.Errorf("ISOWeek(2026, 38).Start = %s, want 2026-09-14", got)
+	}
+	if !week.IsLong() {
+		t.Errorf("ISOWeek(2026, 38) has %d workdays, want a long week", week.Workdays)
+	}
+
+	if _, err := checker.ISOWeek(2026, 54); err == nil {
+		t.Error("expected error for week 54")
+	}
+	if _, err := checker.ISOWeek(2026, 0); err == nil {
+		t.Error("expected error for week 0")
+	}
+}